@@ -0,0 +1,203 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cachedLeaf is the latest value of a path.
+type cachedLeaf struct {
+	path      *pb.Path
+	val       *pb.TypedValue
+	timestamp int64
+	// received is when the value was received, to purge the values a target
+	// didn't send again after resubscribing.
+	received time.Time
+}
+
+// cache holds the latest values of the paths of targets.
+type cache struct {
+	mu sync.RWMutex
+	// leaves holds the values of every target by xpath.
+	leaves map[string]map[string]*cachedLeaf
+}
+
+func newCache() *cache {
+	return &cache{leaves: map[string]map[string]*cachedLeaf{}}
+}
+
+// update applies the deletes then the updates of a notification from target.
+func (c *cache) update(target string, n *pb.Notification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	leaves := c.leaves[target]
+	if leaves == nil {
+		leaves = map[string]*cachedLeaf{}
+		c.leaves[target] = leaves
+	}
+	for _, d := range n.GetDelete() {
+		deleted := joinPaths(n.GetPrefix(), d)
+		for x, leaf := range leaves {
+			if matchPath(deleted.GetElem(), leaf.path.GetElem()) {
+				delete(leaves, x)
+			}
+		}
+	}
+	now := time.Now()
+	for _, u := range n.GetUpdate() {
+		path := joinPaths(n.GetPrefix(), u.GetPath())
+		leaves[xpath.ToXPath(path)] = &cachedLeaf{path: path, val: u.GetVal(), timestamp: n.GetTimestamp(), received: now}
+	}
+}
+
+// purge deletes the values of target received before since.
+func (c *cache) purge(target string, since time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for x, leaf := range c.leaves[target] {
+		if leaf.received.Before(since) {
+			delete(c.leaves[target], x)
+		}
+	}
+}
+
+// query returns a notification for every value of the paths matching query,
+// of target or of all targets if target is empty, ordered by target and path.
+func (c *cache) query(target string, query *pb.Path) []*pb.Notification {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var targets []string
+	for t := range c.leaves {
+		if target == "" || target == t {
+			targets = append(targets, t)
+		}
+	}
+	sort.Strings(targets)
+	var notifications []*pb.Notification
+	for _, t := range targets {
+		var paths []string
+		for x, leaf := range c.leaves[t] {
+			if matchPath(query.GetElem(), leaf.path.GetElem()) {
+				paths = append(paths, x)
+			}
+		}
+		sort.Strings(paths)
+		for _, x := range paths {
+			leaf := c.leaves[t][x]
+			notifications = append(notifications, &pb.Notification{
+				Timestamp: leaf.timestamp,
+				Prefix:    &pb.Path{Target: t},
+				Update:    []*pb.Update{{Path: leaf.path, Val: leaf.val}},
+			})
+		}
+	}
+	return notifications
+}
+
+// matchPath returns true if path is query or below it. Names and key values
+// of query may be the "*" wildcard, and "..." matches any number of elements.
+func matchPath(query, path []*pb.PathElem) bool {
+	return prefixLen(query, path) >= 0
+}
+
+// prefixLen returns the number of the first elements of path that query
+// matches, the fewest if "..." allows several, or -1 if it doesn't match.
+func prefixLen(query, path []*pb.PathElem) int {
+	if len(query) == 0 {
+		return 0
+	}
+	if query[0].GetName() == "..." {
+		for i := 0; i <= len(path); i++ {
+			if n := prefixLen(query[1:], path[i:]); n >= 0 {
+				return i + n
+			}
+		}
+		return -1
+	}
+	if len(path) == 0 || !matchElem(query[0], path[0]) {
+		return -1
+	}
+	if n := prefixLen(query[1:], path[1:]); n >= 0 {
+		return n + 1
+	}
+	return -1
+}
+
+func matchElem(query, elem *pb.PathElem) bool {
+	if query.GetName() != "*" && query.GetName() != elem.GetName() {
+		return false
+	}
+	for k, v := range query.GetKey() {
+		if v != "*" && elem.GetKey()[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// cacheServer serves gNMI Get requests from a cache.
+type cacheServer struct {
+	pb.UnimplementedGNMIServer
+	cache *cache
+}
+
+// Get returns the latest values of the paths of the request, of the target of
+// its prefix or of all targets.
+func (s *cacheServer) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+	if req.GetType() != pb.GetRequest_ALL {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported request type: %s", req.GetType())
+	}
+	resp := &pb.GetResponse{}
+	for _, path := range req.GetPath() {
+		full := joinPaths(req.GetPrefix(), path)
+		notifications := s.cache.query(req.GetPrefix().GetTarget(), full)
+		if len(notifications) == 0 {
+			return nil, status.Errorf(codes.NotFound, "no values of %s in the cache", xpath.ToXPath(full))
+		}
+		resp.Notification = append(resp.Notification, notifications...)
+	}
+	return resp, nil
+}
+
+// serveCache serves Get requests from c on address until the returned
+// function is called.
+func serveCache(c *cache, address string) (func(), error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	srv := grpc.NewServer()
+	pb.RegisterGNMIServer(srv, &cacheServer{cache: c})
+	log.Infof("Serving the cache on %s", lis.Addr())
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			log.Errorf("Serving the cache failed: %v", err)
+		}
+	}()
+	return srv.Stop, nil
+}
@@ -0,0 +1,181 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/gnxi/utils/xpath"
+	"github.com/kylelemons/godebug/pretty"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mustPath(t *testing.T, x string) *pb.Path {
+	t.Helper()
+	p, err := xpath.ToGNMIPath(x)
+	if err != nil {
+		t.Fatalf("ToGNMIPath(%q) failed: %v", x, err)
+	}
+	return p
+}
+
+// cached returns the "target xpath value" lines of the values of query.
+func cached(c *cache, target string, query *pb.Path) []string {
+	var lines []string
+	for _, n := range c.query(target, query) {
+		for _, u := range n.GetUpdate() {
+			lines = append(lines, n.GetPrefix().GetTarget()+" "+xpath.ToXPath(u.GetPath())+" "+valueString(u.GetVal()))
+		}
+	}
+	return lines
+}
+
+func TestCache(t *testing.T) {
+	uint := func(u uint64) *pb.TypedValue { return &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: u}} }
+	c := newCache()
+	c.update("lab1", &pb.Notification{
+		Prefix: mustPath(t, "/interfaces"),
+		Update: []*pb.Update{
+			{Path: mustPath(t, "interface[name=eth0]/state/mtu"), Val: uint(1500)},
+			{Path: mustPath(t, "interface[name=eth0]/state/counters/in-pkts"), Val: uint(10)},
+			{Path: mustPath(t, "interface[name=eth1]/state/mtu"), Val: uint(9000)},
+		},
+	})
+	c.update("lab2", &pb.Notification{
+		Update: []*pb.Update{{Path: mustPath(t, "/interfaces/interface[name=eth0]/state/mtu"), Val: uint(1400)}},
+	})
+
+	tests := []struct {
+		name   string
+		target string
+		query  string
+		want   []string
+	}{
+		{"All targets", "", "/interfaces/interface[name=eth0]/state/mtu", []string{
+			"lab1 /interfaces/interface[name=eth0]/state/mtu 1500",
+			"lab2 /interfaces/interface[name=eth0]/state/mtu 1400",
+		}},
+		{"Subtree of a target", "lab1", "/interfaces/interface[name=eth0]", []string{
+			"lab1 /interfaces/interface[name=eth0]/state/counters/in-pkts 10",
+			"lab1 /interfaces/interface[name=eth0]/state/mtu 1500",
+		}},
+		{"Wildcards", "lab1", "/interfaces/interface[name=*]/*/mtu", []string{
+			"lab1 /interfaces/interface[name=eth0]/state/mtu 1500",
+			"lab1 /interfaces/interface[name=eth1]/state/mtu 9000",
+		}},
+		{"Any number of elements", "lab1", "/interfaces/.../in-pkts", []string{
+			"lab1 /interfaces/interface[name=eth0]/state/counters/in-pkts 10",
+		}},
+		{"Unknown target", "lab3", "/", nil},
+	}
+	for _, test := range tests {
+		if diff := pretty.Compare(test.want, cached(c, test.target, mustPath(t, test.query))); diff != "" {
+			t.Errorf("%s: query(%q, %s): (-want +got)\n%s", test.name, test.target, test.query, diff)
+		}
+	}
+
+	c.update("lab1", &pb.Notification{
+		Prefix: mustPath(t, "/interfaces"),
+		Delete: []*pb.Path{mustPath(t, "interface[name=eth0]")},
+		Update: []*pb.Update{{Path: mustPath(t, "interface[name=eth1]/state/mtu"), Val: uint(1500)}},
+	})
+	want := []string{"lab1 /interfaces/interface[name=eth1]/state/mtu 1500"}
+	if diff := pretty.Compare(want, cached(c, "lab1", mustPath(t, "/"))); diff != "" {
+		t.Errorf("query after delete: (-want +got)\n%s", diff)
+	}
+
+	since := time.Now().Add(time.Second)
+	c.purge("lab2", since)
+	if got := cached(c, "lab2", mustPath(t, "/")); got != nil {
+		t.Errorf("query after purge: got %v, want no values", got)
+	}
+	if got := cached(c, "lab1", mustPath(t, "/")); len(got) != 1 {
+		t.Errorf("purge of lab2 deleted values of lab1, got %v", got)
+	}
+}
+
+func TestCacheServerGet(t *testing.T) {
+	c := newCache()
+	c.update("lab1", &pb.Notification{
+		Timestamp: 42,
+		Update: []*pb.Update{{
+			Path: mustPath(t, "/system/config/hostname"),
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "lab1"}},
+		}},
+	})
+	s := &cacheServer{cache: c}
+	resp, err := s.Get(context.Background(), &pb.GetRequest{
+		Prefix: &pb.Path{Target: "lab1", Elem: mustPath(t, "/system").Elem},
+		Path:   []*pb.Path{mustPath(t, "config")},
+	})
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(resp.Notification) != 1 || resp.Notification[0].GetTimestamp() != 42 || resp.Notification[0].GetPrefix().GetTarget() != "lab1" {
+		t.Errorf("Get returned %v, want the notification of /system/config/hostname of lab1", resp)
+	}
+	if _, err := s.Get(context.Background(), &pb.GetRequest{Prefix: &pb.Path{Target: "lab2"}, Path: []*pb.Path{{}}}); status.Code(err) != codes.NotFound {
+		t.Errorf("Get of an unknown target returned %v, want NotFound", err)
+	}
+}
+
+func TestCacheReconnect(t *testing.T) {
+	update := func(x string) recv {
+		return recv{res: &pb.SubscribeResponse{Response: &pb.SubscribeResponse_Update{Update: &pb.Notification{
+			Update: []*pb.Update{{Path: mustPath(t, x), Val: &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1}}}},
+		}}}}
+	}
+	sync := recv{res: &pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}}}
+	dropped := recv{err: status.Error(codes.Unavailable, "EOF")}
+	gnmi := &fakeGNMI{streams: [][]recv{
+		{update("/a"), update("/b"), sync, dropped},
+		// /b was deleted while disconnected.
+		{update("/a"), sync, dropped},
+		{{err: status.Error(codes.Unimplemented, "no")}},
+	}}
+	after = func(time.Duration) <-chan time.Time {
+		c := make(chan time.Time, 1)
+		c <- time.Time{}
+		return c
+	}
+	defer func() { after = time.After }()
+	cache := newCache()
+	c := &collector{
+		list:              &pb.SubscriptionList{},
+		resumeUpdatesOnly: true,
+		backoff:           time.Second,
+		maxBackoff:        time.Second,
+		sinks:             []sink{cache},
+	}
+	client := &Client{Target: &Target{Name: "lab1"}, gnmi: gnmi}
+	if err := c.run(context.Background(), client, newPrinter(ioutil.Discard, formatFlat, "lab1", true)); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("run returned %v, want the Unimplemented error", err)
+	}
+	for i, req := range gnmi.requests {
+		if req.GetSubscribe().GetUpdatesOnly() {
+			t.Errorf("request %d has updates_only, want all values to purge deleted ones", i+1)
+		}
+	}
+	want := []string{"lab1 /a 1"}
+	if diff := pretty.Compare(want, cached(cache, "", &pb.Path{})); diff != "" {
+		t.Errorf("cache after reconnecting: (-want +got)\n%s", diff)
+	}
+}
@@ -0,0 +1,199 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// capabilitiesCommand gets the capabilities of targets.
+type capabilitiesCommand struct {
+	check            bool
+	encodingNames    arrayFlags
+	pbModelDataFlags arrayFlags
+	format           string
+}
+
+// NewCapabilities returns the capabilities command.
+func NewCapabilities() Command {
+	return &capabilitiesCommand{}
+}
+
+func (c *capabilitiesCommand) Name() string {
+	return "capabilities"
+}
+
+func (c *capabilitiesCommand) Synopsis() string {
+	return "Gets the models, encodings and gNMI version supported by targets"
+}
+
+func (c *capabilitiesCommand) SetFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&c.check, "check", false, "If true, check that the targets support the -encoding encodings, the -model_data models and -min_gnmi_version, and fail if any doesn't")
+	fs.Var(&c.encodingNames, "encoding", "Encoding the targets must support with -check, like JSON_IETF")
+	fs.Var(&c.pbModelDataFlags, "model_data", "Data model the targets must support with -check, in the format of 'name,organization,version'")
+	fs.StringVar(&c.format, "format", formatProto, "Output format, proto for the CapabilitiesResponse in protobuf text format, json for a report of all targets, or jsonl for a report per line")
+}
+
+// errCapabilitiesMismatch is returned by -check when targets don't meet the
+// requirements, after printing them.
+var errCapabilitiesMismatch = errors.New("targets don't support the required capabilities")
+
+// capabilityReport is the machine-readable report of the capabilities of a
+// target.
+type capabilityReport struct {
+	Target             string        `json:"target"`
+	GNMIVersion        string        `json:"gnmi_version,omitempty"`
+	SupportedEncodings []string      `json:"supported_encodings,omitempty"`
+	SupportedModels    []modelReport `json:"supported_models,omitempty"`
+	// Mismatches are the requirements of -check the target doesn't meet.
+	Mismatches []string `json:"mismatches,omitempty"`
+	// Error is set if the capabilities couldn't be retrieved.
+	Error string `json:"error,omitempty"`
+}
+
+type modelReport struct {
+	Name         string `json:"name"`
+	Organization string `json:"organization,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+func newCapabilityReport(target string, resp *pb.CapabilityResponse) *capabilityReport {
+	r := &capabilityReport{Target: target, GNMIVersion: resp.GetGNMIVersion()}
+	for _, e := range resp.GetSupportedEncodings() {
+		r.SupportedEncodings = append(r.SupportedEncodings, e.String())
+	}
+	for _, m := range resp.GetSupportedModels() {
+		r.SupportedModels = append(r.SupportedModels, modelReport{Name: m.GetName(), Organization: m.GetOrganization(), Version: m.GetVersion()})
+	}
+	return r
+}
+
+// requirements returns the requirements checked by -check.
+func (c *capabilitiesCommand) requirements() (*capabilityRequirements, error) {
+	r := &capabilityRequirements{minVersion: *minGNMIVersion}
+	for _, name := range c.encodingNames {
+		e, err := parseEncoding(name)
+		if err != nil {
+			return nil, err
+		}
+		r.encodings = append(r.encodings, e)
+	}
+	var err error
+	if r.models, err = parseModelData(c.pbModelDataFlags); err != nil {
+		return nil, fmt.Errorf("Error parsing models: %v", err)
+	}
+	return r, nil
+}
+
+func (c *capabilitiesCommand) Run(ctx context.Context, clients []*Client) error {
+	switch c.format {
+	case formatProto, formatJSON, formatJSONL:
+	default:
+		return fmt.Errorf("invalid -format %q of capabilities, want proto, json or jsonl", c.format)
+	}
+	requirements, err := c.requirements()
+	if err != nil {
+		return err
+	}
+	if c.format == formatProto {
+		return c.runProto(ctx, clients, requirements)
+	}
+	var reports []*capabilityReport
+	var failed []string
+	mismatch := false
+	for _, client := range clients {
+		ctx, cancel := requestContext(ctx)
+		capResponse, err := client.Capabilities(ctx, &pb.CapabilityRequest{})
+		cancel()
+		if err != nil {
+			reports = append(reports, &capabilityReport{Target: client.Target.Name, Error: err.Error()})
+			failed = append(failed, fmt.Sprintf("%s: error in getting capabilities: %v", client.Target.Name, err))
+			continue
+		}
+		r := newCapabilityReport(client.Target.Name, capResponse)
+		if c.check {
+			r.Mismatches = requirements.mismatches(capResponse)
+			mismatch = mismatch || len(r.Mismatches) > 0
+		}
+		reports = append(reports, r)
+	}
+	if err := writeReports(os.Stdout, c.format, reports); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, "; "))
+	}
+	if mismatch {
+		return errCapabilitiesMismatch
+	}
+	return nil
+}
+
+// runProto prints the responses in protobuf text format, followed by the
+// mismatches of -check.
+func (c *capabilitiesCommand) runProto(ctx context.Context, clients []*Client, requirements *capabilityRequirements) error {
+	mismatch := false
+	err := forEach(clients, true, func(client *Client) error {
+		ctx, cancel := requestContext(ctx)
+		defer cancel()
+		capResponse, err := client.Capabilities(ctx, &pb.CapabilityRequest{})
+		if err != nil {
+			return fmt.Errorf("error in getting capabilities: %v", err)
+		}
+		fmt.Println("== CapabilitiesResponse:\n", proto.MarshalTextString(capResponse))
+		if c.check {
+			mismatches := requirements.mismatches(capResponse)
+			for _, m := range mismatches {
+				fmt.Println("== Mismatch:", m)
+			}
+			mismatch = mismatch || len(mismatches) > 0
+		}
+		return nil
+	})
+	if err == nil && mismatch {
+		return errCapabilitiesMismatch
+	}
+	return err
+}
+
+// writeReports writes the reports as a JSON array, or a JSON object per line.
+func writeReports(w io.Writer, format string, reports []*capabilityReport) error {
+	if format == formatJSONL {
+		enc := json.NewEncoder(w)
+		for _, r := range reports {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	b, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
@@ -0,0 +1,197 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+var (
+	checkCapabilities = flag.Bool("check_capabilities", false, "If true, get the capabilities of the targets first, and fail before any Get, Set or Subscribe whose encoding or models they don't support, or if their gNMI version is older than -min_gnmi_version")
+	minGNMIVersion    = flag.String("min_gnmi_version", "", "Minimum gNMI version of the targets, like 0.7.0, checked with -check_capabilities and capabilities -check")
+)
+
+// capabilityRequirements are the encodings, models and gNMI version that
+// requests need from targets.
+type capabilityRequirements struct {
+	encodings  []pb.Encoding
+	models     []*pb.ModelData
+	minVersion string
+}
+
+// mismatches returns the requirements that the capabilities of a target
+// don't meet.
+func (r *capabilityRequirements) mismatches(capabilities *pb.CapabilityResponse) []string {
+	var mismatches []string
+	supported := map[pb.Encoding]bool{}
+	var names []string
+	for _, e := range capabilities.GetSupportedEncodings() {
+		supported[e] = true
+		names = append(names, e.String())
+	}
+	for _, e := range r.encodings {
+		if !supported[e] {
+			mismatches = append(mismatches, fmt.Sprintf("encoding %s is not supported, the target supports %s", e, orNone(names)))
+		}
+	}
+	for _, m := range r.models {
+		if msg := modelMismatch(m, capabilities.GetSupportedModels()); msg != "" {
+			mismatches = append(mismatches, msg)
+		}
+	}
+	if r.minVersion != "" {
+		version := capabilities.GetGNMIVersion()
+		older, err := olderVersion(version, r.minVersion)
+		switch {
+		case err != nil:
+			mismatches = append(mismatches, fmt.Sprintf("cannot compare gNMI version %q with %s: %v", version, r.minVersion, err))
+		case older:
+			mismatches = append(mismatches, fmt.Sprintf("gNMI version %s is older than %s", version, r.minVersion))
+		}
+	}
+	return mismatches
+}
+
+// modelMismatch returns why the target doesn't support the model m, or "" if
+// it does. Models are supported if their name, organization and version
+// match, like the target checks them.
+func modelMismatch(m *pb.ModelData, supported []*pb.ModelData) string {
+	var versions []string
+	for _, s := range supported {
+		if s.GetName() != m.GetName() {
+			continue
+		}
+		if s.GetOrganization() == m.GetOrganization() && s.GetVersion() == m.GetVersion() {
+			return ""
+		}
+		versions = append(versions, modelString(s))
+	}
+	if len(versions) == 0 {
+		return fmt.Sprintf("model %s is not supported", modelString(m))
+	}
+	sort.Strings(versions)
+	return fmt.Sprintf("model %s is not supported, the target supports %s", modelString(m), strings.Join(versions, ", "))
+}
+
+// modelString returns a model in the name,organization,version format of
+// -model_data.
+func modelString(m *pb.ModelData) string {
+	return strings.Join([]string{m.GetName(), m.GetOrganization(), m.GetVersion()}, ",")
+}
+
+func orNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// olderVersion returns true if the dotted version v is older than min.
+func olderVersion(v, min string) (bool, error) {
+	vParts, err := versionParts(v)
+	if err != nil {
+		return false, err
+	}
+	minParts, err := versionParts(min)
+	if err != nil {
+		return false, err
+	}
+	for i := 0; i < len(vParts) || i < len(minParts); i++ {
+		var a, b int
+		if i < len(vParts) {
+			a = vParts[i]
+		}
+		if i < len(minParts) {
+			b = minParts[i]
+		}
+		if a != b {
+			return a < b, nil
+		}
+	}
+	return false, nil
+}
+
+func versionParts(v string) ([]int, error) {
+	var parts []int
+	for _, s := range strings.Split(strings.TrimPrefix(v, "v"), ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q", v)
+		}
+		parts = append(parts, n)
+	}
+	return parts, nil
+}
+
+// getRequirements returns the requirements of a GetRequest.
+func getRequirements(req *pb.GetRequest) *capabilityRequirements {
+	return &capabilityRequirements{encodings: []pb.Encoding{req.GetEncoding()}, models: req.GetUseModels()}
+}
+
+// setRequestEncodings returns the encodings of the JSON values of a
+// SetRequest. Scalar values don't need an encoding.
+func setRequestEncodings(req *pb.SetRequest) []pb.Encoding {
+	used := map[pb.Encoding]bool{}
+	for _, u := range append(append([]*pb.Update{}, req.GetReplace()...), req.GetUpdate()...) {
+		switch u.GetVal().GetValue().(type) {
+		case *pb.TypedValue_JsonVal:
+			used[pb.Encoding_JSON] = true
+		case *pb.TypedValue_JsonIetfVal:
+			used[pb.Encoding_JSON_IETF] = true
+		}
+	}
+	var encodings []pb.Encoding
+	for e := range used {
+		encodings = append(encodings, e)
+	}
+	sort.Slice(encodings, func(i, j int) bool { return encodings[i] < encodings[j] })
+	return encodings
+}
+
+// checkTargets gets the capabilities of the targets and returns an error
+// listing the requirements that any doesn't meet, if -check_capabilities is
+// set.
+func checkTargets(ctx context.Context, clients []*Client, r *capabilityRequirements) error {
+	if !*checkCapabilities {
+		return nil
+	}
+	r.minVersion = *minGNMIVersion
+	var errs []string
+	for _, client := range clients {
+		ctx, cancel := requestContext(ctx)
+		capabilities, err := client.Capabilities(ctx, &pb.CapabilityRequest{})
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: error in getting capabilities: %v", client.Target.Name, err))
+			continue
+		}
+		for _, m := range r.mismatches(capabilities) {
+			errs = append(errs, fmt.Sprintf("%s: %s", client.Target.Name, m))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New("capabilities check failed: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+)
+
+var testCapabilities = &pb.CapabilityResponse{
+	SupportedModels: []*pb.ModelData{
+		{Name: "openconfig-interfaces", Organization: "OpenConfig working group", Version: "2.0.0"},
+		{Name: "openconfig-system", Organization: "OpenConfig working group", Version: "0.2.0"},
+	},
+	SupportedEncodings: []pb.Encoding{pb.Encoding_JSON, pb.Encoding_JSON_IETF},
+	GNMIVersion:        "0.7.0",
+}
+
+func TestMismatches(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements *capabilityRequirements
+		want         []string
+	}{
+		{
+			name: "Supported",
+			requirements: &capabilityRequirements{
+				encodings:  []pb.Encoding{pb.Encoding_JSON_IETF},
+				models:     []*pb.ModelData{{Name: "openconfig-system", Organization: "OpenConfig working group", Version: "0.2.0"}},
+				minVersion: "0.6",
+			},
+		},
+		{
+			name: "Unsupported",
+			requirements: &capabilityRequirements{
+				encodings: []pb.Encoding{pb.Encoding_PROTO, pb.Encoding_JSON},
+				models: []*pb.ModelData{
+					{Name: "openconfig-interfaces", Organization: "OpenConfig working group", Version: "2.4.3"},
+					{Name: "openconfig-bgp", Organization: "OpenConfig working group", Version: "6.0.0"},
+				},
+				minVersion: "0.7.1",
+			},
+			want: []string{
+				"encoding PROTO is not supported, the target supports JSON, JSON_IETF",
+				"model openconfig-interfaces,OpenConfig working group,2.4.3 is not supported, the target supports openconfig-interfaces,OpenConfig working group,2.0.0",
+				"model openconfig-bgp,OpenConfig working group,6.0.0 is not supported",
+				"gNMI version 0.7.0 is older than 0.7.1",
+			},
+		},
+		{
+			name:         "Invalid version",
+			requirements: &capabilityRequirements{minVersion: "latest"},
+			want:         []string{`cannot compare gNMI version "0.7.0" with latest: invalid version "latest"`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.requirements.mismatches(testCapabilities)
+			if diff := pretty.Compare(test.want, got); diff != "" {
+				t.Errorf("mismatches returned diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOlderVersion(t *testing.T) {
+	tests := []struct {
+		v, min string
+		want   bool
+	}{
+		{"0.7.0", "0.7.0", false},
+		{"0.7.0", "0.7", false},
+		{"0.6.1", "0.7.0", true},
+		{"0.10.0", "0.7.0", false},
+		{"v1.0", "0.8.0", false},
+		{"0.7", "0.7.1", true},
+	}
+	for _, test := range tests {
+		got, err := olderVersion(test.v, test.min)
+		if err != nil || got != test.want {
+			t.Errorf("olderVersion(%q, %q) = %v, %v, want %v", test.v, test.min, got, err, test.want)
+		}
+	}
+	if _, err := olderVersion("", "0.7.0"); err == nil {
+		t.Error("olderVersion of an empty version succeeded")
+	}
+}
+
+func TestSetRequestEncodings(t *testing.T) {
+	req := &pb.SetRequest{
+		Replace: []*pb.Update{{Val: &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte("{}")}}}},
+		Update: []*pb.Update{
+			{Val: &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1}}},
+			{Val: &pb.TypedValue{Value: &pb.TypedValue_JsonVal{JsonVal: []byte("{}")}}},
+			{Val: &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte("{}")}}},
+		},
+	}
+	want := []pb.Encoding{pb.Encoding_JSON, pb.Encoding_JSON_IETF}
+	if diff := pretty.Compare(want, setRequestEncodings(req)); diff != "" {
+		t.Errorf("setRequestEncodings returned diff (-want +got):\n%s", diff)
+	}
+}
+
+// fakeCapabilities returns its capabilities.
+type fakeCapabilities struct {
+	pb.GNMIClient
+	resp *pb.CapabilityResponse
+}
+
+func (f *fakeCapabilities) Capabilities(ctx context.Context, in *pb.CapabilityRequest, opts ...grpc.CallOption) (*pb.CapabilityResponse, error) {
+	return f.resp, nil
+}
+
+func TestCheckTargets(t *testing.T) {
+	clients := []*Client{
+		{Target: &Target{Name: "lab1"}, gnmi: &fakeCapabilities{resp: testCapabilities}},
+		{Target: &Target{Name: "lab2"}, gnmi: &fakeCapabilities{resp: &pb.CapabilityResponse{SupportedEncodings: []pb.Encoding{pb.Encoding_JSON}}}},
+	}
+	r := &capabilityRequirements{encodings: []pb.Encoding{pb.Encoding_JSON_IETF}}
+	if err := checkTargets(context.Background(), clients, r); err != nil {
+		t.Errorf("checkTargets without -check_capabilities failed: %v", err)
+	}
+	*checkCapabilities = true
+	defer func() { *checkCapabilities = false }()
+	err := checkTargets(context.Background(), clients, r)
+	want := "capabilities check failed: lab2: encoding JSON_IETF is not supported, the target supports JSON"
+	if err == nil || err.Error() != want {
+		t.Errorf("checkTargets returned error %v, want %q", err, want)
+	}
+}
+
+func TestWriteReports(t *testing.T) {
+	r := newCapabilityReport("lab1", &pb.CapabilityResponse{
+		SupportedModels:    []*pb.ModelData{{Name: "openconfig-system", Organization: "OpenConfig working group", Version: "0.2.0"}},
+		SupportedEncodings: []pb.Encoding{pb.Encoding_JSON_IETF},
+		GNMIVersion:        "0.7.0",
+	})
+	r.Mismatches = []string{"encoding PROTO is not supported, the target supports JSON_IETF"}
+	reports := []*capabilityReport{r, {Target: "lab2", Error: "unavailable"}}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{formatJSONL, `{"target":"lab1","gnmi_version":"0.7.0","supported_encodings":["JSON_IETF"],"supported_models":[{"name":"openconfig-system","organization":"OpenConfig working group","version":"0.2.0"}],"mismatches":["encoding PROTO is not supported, the target supports JSON_IETF"]}
+{"target":"lab2","error":"unavailable"}
+`},
+		{formatJSON, `[
+  {
+    "target": "lab1",
+    "gnmi_version": "0.7.0",
+    "supported_encodings": [
+      "JSON_IETF"
+    ],
+    "supported_models": [
+      {
+        "name": "openconfig-system",
+        "organization": "OpenConfig working group",
+        "version": "0.2.0"
+      }
+    ],
+    "mismatches": [
+      "encoding PROTO is not supported, the target supports JSON_IETF"
+    ]
+  },
+  {
+    "target": "lab2",
+    "error": "unavailable"
+  }
+]
+`},
+	}
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := writeReports(&b, test.format, reports); err != nil {
+			t.Fatalf("writeReports(%s) failed: %v", test.format, err)
+		}
+		if got := b.String(); got != test.want {
+			t.Errorf("writeReports(%s) = %s, want %s", test.format, got, test.want)
+		}
+	}
+}
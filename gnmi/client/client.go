@@ -0,0 +1,138 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client implements the gNMI client commands shared by the gnmi
+// binary and the gnmi_get, gnmi_set, gnmi_subscribe and gnmi_capabilities
+// binaries.
+//
+// Responses are printed in the format set by -format. Every format resolves the
+// paths of updates and deletes to xpaths, except the default proto format,
+// which keeps printing the raw Path messages for backward compatibility; use
+// proto_xpaths for the protobuf text format with xpaths.
+package client
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+)
+
+var (
+	profileFile = flag.String("profile", "", "Connection profile file (YAML or JSON) holding named targets. If not set, -target_addr and the credentials flags are used")
+	targetNames = flag.String("target", "", "Comma separated names of the -profile targets to run against, or all. The profile's default target if not set")
+	targetAddr  = flag.String("target_addr", "localhost:9339", "The target address in the format of host:port")
+	timeOut     = flag.Duration("time_out", 10*time.Second, "Timeout for requests, 10 seconds by default. Subscriptions only time out if set")
+)
+
+func init() {
+	flag.DurationVar(timeOut, "timeout", 10*time.Second, "Alias of -time_out")
+}
+
+// Client is a gNMI client for a target, attaching the target's credentials to
+// every RPC.
+type Client struct {
+	Target *Target
+	gnmi   pb.GNMIClient
+	conn   *grpc.ClientConn
+}
+
+// Dial connects to a target.
+func Dial(t *Target) (*Client, error) {
+	opts, err := t.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(t.Address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("dialing to %q failed: %v", t.Address, err)
+	}
+	return &Client{Target: t, gnmi: pb.NewGNMIClient(conn), conn: conn}, nil
+}
+
+// Connect connects to the targets selected by the -profile and -target flags,
+// or the target configured by -target_addr and the credentials flags.
+func Connect() ([]*Client, error) {
+	targets := []*Target{flagTarget()}
+	if *profileFile != "" {
+		profile, err := LoadProfile(*profileFile)
+		if err != nil {
+			return nil, err
+		}
+		if targets, err = profile.Select(strings.FieldsFunc(*targetNames, func(r rune) bool { return r == ',' })); err != nil {
+			return nil, err
+		}
+	} else if *targetNames != "" {
+		return nil, fmt.Errorf("-target requires a -profile")
+	}
+	var clients []*Client
+	for _, t := range targets {
+		c, err := Dial(t)
+		if err != nil {
+			for _, c := range clients {
+				c.Close()
+			}
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	return clients, nil
+}
+
+// Close closes the connection to the target.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Capabilities invokes the Capabilities RPC.
+func (c *Client) Capabilities(ctx context.Context, in *pb.CapabilityRequest, opts ...grpc.CallOption) (*pb.CapabilityResponse, error) {
+	return c.gnmi.Capabilities(c.Target.attach(ctx), in, opts...)
+}
+
+// Get invokes the Get RPC.
+func (c *Client) Get(ctx context.Context, in *pb.GetRequest, opts ...grpc.CallOption) (*pb.GetResponse, error) {
+	return c.gnmi.Get(c.Target.attach(ctx), in, opts...)
+}
+
+// Set invokes the Set RPC.
+func (c *Client) Set(ctx context.Context, in *pb.SetRequest, opts ...grpc.CallOption) (*pb.SetResponse, error) {
+	return c.gnmi.Set(c.Target.attach(ctx), in, opts...)
+}
+
+// Subscribe invokes the Subscribe RPC.
+func (c *Client) Subscribe(ctx context.Context, opts ...grpc.CallOption) (pb.GNMI_SubscribeClient, error) {
+	return c.gnmi.Subscribe(c.Target.attach(ctx), opts...)
+}
+
+// requestContext returns a context bounded by -time_out.
+func requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, *timeOut)
+}
+
+// streamContext returns a context bounded by -time_out if it was set.
+func streamContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		set = set || f.Name == "time_out" || f.Name == "timeout"
+	})
+	if !set || *timeOut == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, *timeOut)
+}
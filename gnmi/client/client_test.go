@@ -0,0 +1,59 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+var (
+	tempDirsMu sync.Mutex
+	// tempDirs holds the temporary directory of every running test.
+	tempDirs = map[*testing.T]string{}
+)
+
+// writeTempFile writes content to a file named name in a temporary directory
+// removed when the test ends, and returns its path. The files of a test share
+// the directory, so that they can refer to each other by name.
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	tempDirsMu.Lock()
+	dir, ok := tempDirs[t]
+	if !ok {
+		var err error
+		if dir, err = ioutil.TempDir("", "client"); err != nil {
+			tempDirsMu.Unlock()
+			t.Fatal(err)
+		}
+		tempDirs[t] = dir
+		t.Cleanup(func() {
+			tempDirsMu.Lock()
+			delete(tempDirs, t)
+			tempDirsMu.Unlock()
+			os.RemoveAll(dir)
+		})
+	}
+	tempDirsMu.Unlock()
+	file := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
@@ -0,0 +1,212 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// after is time.After, replaced in tests.
+var after = time.After
+
+// collector subscribes to targets in STREAM mode until it's stopped,
+// resubscribing with exponential backoff when a subscription fails.
+type collector struct {
+	// list is the subscription list of the first subscription to a target.
+	list *pb.SubscriptionList
+	// resumeUpdatesOnly is set to resubscribe with updates_only once the
+	// target sent a sync response, unless there are sinks.
+	resumeUpdatesOnly bool
+	backoff           time.Duration
+	maxBackoff        time.Duration
+	format            string
+	out               io.Writer
+	// sinks consume the notifications received.
+	sinks []sink
+}
+
+// sink consumes the notifications of targets.
+type sink interface {
+	// update applies a notification from target.
+	update(target string, n *pb.Notification)
+	// purge deletes the values of target received before since, when target
+	// sent all its values again.
+	purge(target string, since time.Time)
+}
+
+// errStreamClosed is returned when a target closes a subscription, which
+// never ends in STREAM mode.
+var errStreamClosed = errors.New("subscription closed by the target")
+
+// lockedWriter serializes writes, so that the lines of responses from several
+// targets don't mix.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(b []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(b)
+}
+
+// permanent returns true for errors that resubscribing won't fix.
+func permanent(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.Unauthenticated, codes.Unimplemented:
+		return true
+	}
+	return false
+}
+
+// collect subscribes to all targets concurrently until ctx is done or the
+// process is interrupted. It returns the errors of the targets whose
+// subscription failed permanently.
+func (c *collector) collect(ctx context.Context, clients []*Client) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case s := <-signals:
+			log.Infof("Received %v, stopping", s)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	out := &lockedWriter{w: c.out}
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func(i int, client *Client) {
+			defer wg.Done()
+			errs[i] = c.run(ctx, client, newPrinter(out, c.format, client.Target.Name, true))
+		}(i, client)
+	}
+	wg.Wait()
+	var failed []string
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", clients[i].Target.Name, err))
+		}
+	}
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// run subscribes to a target until ctx is done, or the subscription fails
+// permanently.
+func (c *collector) run(ctx context.Context, client *Client, out *printer) error {
+	backoff := c.backoff
+	synced := false
+	for {
+		list := proto.Clone(c.list).(*pb.SubscriptionList)
+		// Sinks need all values again, to purge those deleted while down.
+		if synced && c.resumeUpdatesOnly && len(c.sinks) == 0 {
+			list.UpdatesOnly = true
+		}
+		gotSync, err := c.subscribe(ctx, client, list, out)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if permanent(err) {
+			log.Errorf("%s: %v, giving up", client.Target.Name, err)
+			return err
+		}
+		if gotSync {
+			// The subscription was up, back off from scratch.
+			synced = true
+			backoff = c.backoff
+		}
+		log.Errorf("%s: %v, resubscribing in %v", client.Target.Name, err, backoff)
+		select {
+		case <-after(backoff):
+		case <-ctx.Done():
+			return nil
+		}
+		if backoff *= 2; backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+}
+
+// subscribe subscribes to a target and prints the responses until the
+// subscription fails, returning whether a sync response was received.
+func (c *collector) subscribe(ctx context.Context, client *Client, list *pb.SubscriptionList, out *printer) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.Subscribe(ctx)
+	if err != nil {
+		return false, err
+	}
+	request := &pb.SubscribeRequest{Request: &pb.SubscribeRequest_Subscribe{Subscribe: list}}
+	log.V(1).Infof("%s: SubscribeRequest:\n%s", client.Target.Name, proto.MarshalTextString(request))
+	started := time.Now()
+	if err := stream.Send(request); err != nil {
+		return false, err
+	}
+	synced := false
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return synced, errStreamClosed
+		}
+		if err != nil {
+			return synced, err
+		}
+		switch r := res.Response.(type) {
+		case *pb.SubscribeResponse_SyncResponse:
+			synced = true
+			if !list.UpdatesOnly {
+				// The target sent all values again, the others are gone.
+				for _, s := range c.sinks {
+					s.purge(client.Target.Name, started)
+				}
+			}
+		case *pb.SubscribeResponse_Update:
+			for _, s := range c.sinks {
+				s.update(client.Target.Name, r.Update)
+			}
+		default:
+			return synced, errors.New("unexpected response type")
+		}
+		if err := out.subscribeResponse(res); err != nil {
+			return synced, err
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recv is a response or error returned by a fake stream.
+type recv struct {
+	res *pb.SubscribeResponse
+	err error
+}
+
+type fakeStream struct {
+	pb.GNMI_SubscribeClient
+	recvs    []recv
+	requests *[]*pb.SubscribeRequest
+}
+
+func (s *fakeStream) Send(req *pb.SubscribeRequest) error {
+	*s.requests = append(*s.requests, req)
+	return nil
+}
+
+func (s *fakeStream) Recv() (*pb.SubscribeResponse, error) {
+	if len(s.recvs) == 0 {
+		return nil, io.EOF
+	}
+	r := s.recvs[0]
+	s.recvs = s.recvs[1:]
+	return r.res, r.err
+}
+
+// fakeGNMI returns a stream per Subscribe call, or fails the call for a nil
+// stream.
+type fakeGNMI struct {
+	pb.GNMIClient
+	streams  [][]recv
+	requests []*pb.SubscribeRequest
+}
+
+func (f *fakeGNMI) Subscribe(ctx context.Context, opts ...grpc.CallOption) (pb.GNMI_SubscribeClient, error) {
+	recvs := f.streams[0]
+	f.streams = f.streams[1:]
+	if recvs == nil {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	return &fakeStream{recvs: recvs, requests: &f.requests}, nil
+}
+
+func TestCollectorRun(t *testing.T) {
+	update := recv{res: &pb.SubscribeResponse{Response: &pb.SubscribeResponse_Update{Update: &pb.Notification{
+		Update: []*pb.Update{{
+			Path: &pb.Path{Elem: []*pb.PathElem{{Name: "a"}}},
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1}},
+		}},
+	}}}}
+	sync := recv{res: &pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}}}
+	dropped := recv{err: status.Error(codes.Unavailable, "EOF")}
+	tests := []struct {
+		name              string
+		resumeUpdatesOnly bool
+		streams           [][]recv
+		wantBackoffs      []time.Duration
+		wantUpdatesOnly   []bool
+		wantOut           string
+	}{
+		{
+			name:              "Resume with updates_only",
+			resumeUpdatesOnly: true,
+			streams: [][]recv{
+				nil,
+				{update, sync, dropped},
+				{},
+				{sync, update, dropped},
+			},
+			wantBackoffs:    []time.Duration{time.Second, time.Second, 2 * time.Second, time.Second},
+			wantUpdatesOnly: []bool{false, true, true},
+			wantOut:         "lab1 /a 1\nlab1 /a 1\n",
+		},
+		{
+			name:            "Resume with initial values",
+			streams:         [][]recv{{update, sync, dropped}, {update, sync}},
+			wantBackoffs:    []time.Duration{time.Second, time.Second},
+			wantUpdatesOnly: []bool{false, false},
+			wantOut:         "lab1 /a 1\nlab1 /a 1\n",
+		},
+		{
+			name:            "Backoff is bounded",
+			streams:         [][]recv{nil, nil, nil, nil},
+			wantBackoffs:    []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second},
+			wantUpdatesOnly: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gnmi := &fakeGNMI{streams: append(test.streams, []recv{{err: status.Error(codes.Unimplemented, "no")}})}
+			client := &Client{Target: &Target{Name: "lab1"}, gnmi: gnmi}
+			var backoffs []time.Duration
+			after = func(d time.Duration) <-chan time.Time {
+				backoffs = append(backoffs, d)
+				c := make(chan time.Time, 1)
+				c <- time.Time{}
+				return c
+			}
+			defer func() { after = time.After }()
+			var out bytes.Buffer
+			c := &collector{
+				list:              &pb.SubscriptionList{},
+				resumeUpdatesOnly: test.resumeUpdatesOnly,
+				backoff:           time.Second,
+				maxBackoff:        3 * time.Second,
+			}
+			err := c.run(context.Background(), client, newPrinter(&out, formatFlat, "lab1", true))
+			if status.Code(err) != codes.Unimplemented {
+				t.Errorf("run returned %v, want the Unimplemented error", err)
+			}
+			if diff := pretty.Compare(test.wantBackoffs, backoffs); diff != "" {
+				t.Errorf("backoffs: (-want +got)\n%s", diff)
+			}
+			var updatesOnly []bool
+			// The last request is of the stream failing permanently.
+			for _, req := range gnmi.requests[:len(gnmi.requests)-1] {
+				updatesOnly = append(updatesOnly, req.GetSubscribe().GetUpdatesOnly())
+			}
+			if diff := pretty.Compare(test.wantUpdatesOnly, updatesOnly); diff != "" {
+				t.Errorf("updates_only of requests: (-want +got)\n%s", diff)
+			}
+			if out.String() != test.wantOut {
+				t.Errorf("output: got %q, want %q", out.String(), test.wantOut)
+			}
+		})
+	}
+}
+
+func TestCollectorCollect(t *testing.T) {
+	var clients []*Client
+	for _, name := range []string{"lab1", "lab2"} {
+		clients = append(clients, &Client{
+			Target: &Target{Name: name},
+			gnmi:   &fakeGNMI{streams: [][]recv{{{err: status.Error(codes.PermissionDenied, "denied")}}}},
+		})
+	}
+	c := &collector{list: &pb.SubscriptionList{}, backoff: time.Second, maxBackoff: time.Second, out: ioutil.Discard}
+	err := c.collect(context.Background(), clients)
+	want := "lab1: rpc error: code = PermissionDenied desc = denied; lab2: rpc error: code = PermissionDenied desc = denied"
+	if err == nil || err.Error() != want {
+		t.Errorf("collect returned %v, want %s", err, want)
+	}
+}
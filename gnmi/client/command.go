@@ -0,0 +1,151 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	log "github.com/golang/glog"
+)
+
+// Command is a gNMI client command.
+type Command interface {
+	// Name returns the name of the command, used as subcommand of the gnmi
+	// binary.
+	Name() string
+	// Synopsis returns a one line description of the command.
+	Synopsis() string
+	// SetFlags registers the flags of the command.
+	SetFlags(fs *flag.FlagSet)
+	// Run runs the command against the connected targets.
+	Run(ctx context.Context, clients []*Client) error
+}
+
+// offlineCommand is implemented by commands that can run without targets,
+// like set with -dry_run.
+type offlineCommand interface {
+	// offline returns true if the command runs without connecting to the
+	// targets, with no clients.
+	offline() bool
+}
+
+// Commands returns all commands.
+func Commands() []Command {
+	return []Command{NewGet(), NewSet(), NewSubscribe(), NewCapabilities(), NewDiff()}
+}
+
+// Main runs cmd as the only command of a binary, its flags next to the
+// connection flags, and exits on failure.
+func Main(cmd Command) {
+	cmd.SetFlags(flag.CommandLine)
+	flag.Set("logtostderr", "true")
+	flag.Parse()
+	if err := run(cmd); err != nil {
+		log.Exit(err)
+	}
+}
+
+// Execute runs the command named by the first argument after the connection
+// flags, and exits on failure. The connection flags can also follow the
+// command name.
+func Execute(cmds ...Command) {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <command> [command flags]\n\nCommands:\n", os.Args[0])
+		for _, cmd := range cmds {
+			fmt.Fprintf(flag.CommandLine.Output(), "  %-14s %s\n", cmd.Name(), cmd.Synopsis())
+		}
+		fmt.Fprintln(flag.CommandLine.Output(), "\nFlags:")
+		flag.PrintDefaults()
+	}
+	flag.Set("logtostderr", "true")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var cmd Command
+	for _, c := range cmds {
+		if c.Name() == flag.Arg(0) {
+			cmd = c
+		}
+	}
+	if cmd == nil {
+		flag.Usage()
+		log.Exitf("Unknown command %q", flag.Arg(0))
+	}
+	fs := flag.NewFlagSet(cmd.Name(), flag.ExitOnError)
+	cmd.SetFlags(fs)
+	flag.VisitAll(func(f *flag.Flag) {
+		fs.Var(f.Value, f.Name, f.Usage)
+	})
+	fs.Parse(flag.Args()[1:])
+	if fs.NArg() > 0 {
+		log.Exitf("Unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	// Connection flags following the command name count as set.
+	fs.Visit(func(f *flag.Flag) {
+		if flag.Lookup(f.Name) != nil {
+			flag.Set(f.Name, f.Value.String())
+		}
+	})
+	if err := run(cmd); err != nil {
+		log.Exit(err)
+	}
+}
+
+// run connects to the targets and runs cmd against them, or runs it without
+// connecting if it is offline.
+func run(cmd Command) error {
+	if c, ok := cmd.(offlineCommand); ok && c.offline() {
+		return cmd.Run(context.Background(), nil)
+	}
+	clients, err := Connect()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, c := range clients {
+			c.Close()
+		}
+	}()
+	return cmd.Run(context.Background(), clients)
+}
+
+// forEach calls f for every client, printing the name of the target first if
+// header is set and there are several. It returns the errors of all targets.
+func forEach(clients []*Client, header bool, f func(c *Client) error) error {
+	var errs []string
+	for _, c := range clients {
+		if header && len(clients) > 1 {
+			fmt.Printf("== Target %s\n", c.Target.Name)
+		}
+		if err := f(c); err != nil {
+			if len(clients) == 1 {
+				return err
+			}
+			errs = append(errs, fmt.Sprintf("%s: %v", c.Target.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
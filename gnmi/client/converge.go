@@ -0,0 +1,152 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/google/gnxi/gnmi"
+	"github.com/google/gnxi/gnmi/modeldata"
+	"github.com/google/gnxi/gnmi/modeldata/gostruct"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// deviceModel returns the model compiled into the gostruct package.
+func deviceModel() *gnmi.Model {
+	return gnmi.NewModel(modeldata.ModelData,
+		reflect.TypeOf((*gostruct.Device)(nil)),
+		gostruct.SchemaTree["Device"],
+		gostruct.Unmarshal,
+		gostruct.ΛEnum)
+}
+
+// configTree returns a config tree holding the updates of notifications.
+func configTree(notifications []*pb.Notification) (ygot.ValidatedGoStruct, error) {
+	root, err := deviceModel().NewConfigStruct(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range notifications {
+		for _, u := range n.GetUpdate() {
+			path := joinPaths(n.GetPrefix(), u.GetPath())
+			if err := ytypes.SetNode(gostruct.SchemaTree["Device"], root, path, u.GetVal(), &ytypes.InitMissingElements{}); err != nil {
+				return nil, fmt.Errorf("cannot set %s: %v", xpath.ToXPath(path), err)
+			}
+		}
+	}
+	return root, nil
+}
+
+// joinPaths returns the path of the elements of prefix followed by those of
+// path.
+func joinPaths(prefix, path *pb.Path) *pb.Path {
+	var elems []*pb.PathElem
+	return &pb.Path{Elem: append(append(elems, prefix.GetElem()...), path.GetElem()...)}
+}
+
+// configLeaves returns the values of the config leaves of tree by xpath.
+func configLeaves(tree ygot.GoStruct) (map[string]string, error) {
+	notifications, err := ygot.TogNMINotifications(tree, 0, ygot.GNMINotificationsConfig{UsePathElem: true})
+	if err != nil {
+		return nil, err
+	}
+	schema := deviceSchema()
+	values := map[string]string{}
+	for _, n := range notifications {
+		for _, u := range n.GetUpdate() {
+			path := joinPaths(n.GetPrefix(), u.GetPath())
+			if readOnly(schema, path) {
+				continue
+			}
+			values[xpath.ToXPath(path)] = valueString(u.GetVal())
+		}
+	}
+	return values, nil
+}
+
+// readOnly returns true if path is a state leaf, which can't be set.
+func readOnly(schema *modelSchema, path *pb.Path) bool {
+	e := schema.find(path)
+	return e != nil && e.ReadOnly()
+}
+
+// convergeRequest returns the SetRequest converging the config leaves of
+// current to those of desired, or nil if they are the same. Deletes are of
+// the outermost containers and list entries below base missing from desired,
+// leaf-lists are replaced and other leaves updated.
+func convergeRequest(base *pb.Path, current, desired ygot.GoStruct) (*pb.SetRequest, error) {
+	diff, err := ygot.Diff(current, desired)
+	if err != nil {
+		return nil, err
+	}
+	schema := deviceSchema()
+	req := &pb.SetRequest{}
+	deleted := map[string]bool{}
+	for _, p := range diff.GetDelete() {
+		if readOnly(schema, p) {
+			continue
+		}
+		p = outermostMissing(base, p, desired)
+		if x := xpath.ToXPath(p); !deleted[x] {
+			deleted[x] = true
+			req.Delete = append(req.Delete, p)
+		}
+	}
+	for _, u := range diff.GetUpdate() {
+		if readOnly(schema, u.GetPath()) {
+			continue
+		}
+		if u.GetVal().GetLeaflistVal() != nil {
+			req.Replace = append(req.Replace, u)
+		} else {
+			req.Update = append(req.Update, u)
+		}
+	}
+	if len(req.Delete) == 0 && len(req.Replace) == 0 && len(req.Update) == 0 {
+		return nil, nil
+	}
+	sortPaths(req.Delete)
+	sortUpdates(req.Replace)
+	sortUpdates(req.Update)
+	return req, nil
+}
+
+// outermostMissing returns the outermost ancestor of path below base, or path
+// itself, that is not in tree.
+func outermostMissing(base, path *pb.Path, tree ygot.GoStruct) *pb.Path {
+	for i := len(base.GetElem()) + 1; i < len(path.GetElem()); i++ {
+		ancestor := &pb.Path{Elem: path.GetElem()[:i]}
+		nodes, err := ytypes.GetNode(gostruct.SchemaTree["Device"], tree, ancestor)
+		if err != nil || len(nodes) == 0 || util.IsValueNil(nodes[0].Data) {
+			return ancestor
+		}
+	}
+	return path
+}
+
+func sortPaths(paths []*pb.Path) {
+	sort.Slice(paths, func(i, j int) bool { return xpath.ToXPath(paths[i]) < xpath.ToXPath(paths[j]) })
+}
+
+func sortUpdates(updates []*pb.Update) {
+	sort.Slice(updates, func(i, j int) bool { return xpath.ToXPath(updates[i].GetPath()) < xpath.ToXPath(updates[j].GetPath()) })
+}
@@ -0,0 +1,115 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/google/gnxi/utils/xpath"
+	"github.com/kylelemons/godebug/pretty"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func subtree(t *testing.T, base *pb.Path, config string) *pb.Notification {
+	t.Helper()
+	return &pb.Notification{Update: []*pb.Update{{
+		Path: base,
+		Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(config)}},
+	}}}
+}
+
+func TestConvergeRequest(t *testing.T) {
+	base, err := xpath.ToGNMIPath("/system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const current = `{
+  "openconfig-system:config": {"hostname": "a", "domain-name": "example.com"},
+  "openconfig-system:dns": {"config": {"search": ["example.com"]}},
+  "openconfig-openflow:openflow": {
+    "agent": {"config": {"max-backoff": 10}, "state": {"max-backoff": 10}},
+    "controllers": {"controller": [
+      {"name": "main", "config": {"name": "main"}},
+      {"name": "backup", "config": {"name": "backup"}}
+    ]}
+  }
+}`
+	const desired = `{
+  "openconfig-system:config": {"hostname": "b"},
+  "openconfig-system:dns": {"config": {"search": ["example.com", "example.net"]}},
+  "openconfig-openflow:openflow": {
+    "agent": {"config": {"max-backoff": 10}},
+    "controllers": {"controller": [{"name": "main", "config": {"name": "main"}}]}
+  }
+}`
+	currentTree, err := configTree([]*pb.Notification{subtree(t, base, current)})
+	if err != nil {
+		t.Fatalf("configTree(current) failed: %v", err)
+	}
+	desiredTree, err := configTree([]*pb.Notification{subtree(t, base, desired)})
+	if err != nil {
+		t.Fatalf("configTree(desired) failed: %v", err)
+	}
+
+	values, err := configLeaves(currentTree)
+	if err != nil {
+		t.Fatalf("configLeaves failed: %v", err)
+	}
+	if _, ok := values["/system/openflow/agent/state/max-backoff"]; ok {
+		t.Error("configLeaves returned state leaf /system/openflow/agent/state/max-backoff")
+	}
+	if got, want := values["/system/dns/config/search"], `["example.com"]`; got != want {
+		t.Errorf("configLeaves: got /system/dns/config/search: %s, want %s", got, want)
+	}
+
+	req, err := convergeRequest(base, currentTree, desiredTree)
+	if err != nil {
+		t.Fatalf("convergeRequest failed: %v", err)
+	}
+	xpaths := func(paths []*pb.Path) []string {
+		var x []string
+		for _, p := range paths {
+			x = append(x, xpath.ToXPath(p))
+		}
+		return x
+	}
+	updated := func(updates []*pb.Update) []string {
+		var x []string
+		for _, u := range updates {
+			x = append(x, xpath.ToXPath(u.GetPath())+" "+valueString(u.GetVal()))
+		}
+		return x
+	}
+	for _, c := range []struct {
+		name      string
+		got, want []string
+	}{
+		{"deletes", xpaths(req.GetDelete()), []string{
+			"/system/config/domain-name",
+			"/system/openflow/controllers/controller[name=backup]",
+		}},
+		{"replaces", updated(req.GetReplace()), []string{`/system/dns/config/search ["example.com","example.net"]`}},
+		{"updates", updated(req.GetUpdate()), []string{"/system/config/hostname b"}},
+	} {
+		if diff := pretty.Compare(c.want, c.got); diff != "" {
+			t.Errorf("convergeRequest %s: (-want +got)\n%s", c.name, diff)
+		}
+	}
+
+	if req, err := convergeRequest(base, desiredTree, desiredTree); err != nil || req != nil {
+		t.Errorf("convergeRequest of equal configs = %v, %v, want nil, nil", req, err)
+	}
+}
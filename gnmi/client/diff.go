@@ -0,0 +1,227 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+var errTargetsDiffer = errors.New("targets differ")
+
+// diffCommand gets the same paths from several targets and compares them, or
+// compares a subtree of targets with a desired config and converges them.
+type diffCommand struct {
+	getCommand
+	desired string
+	apply   bool
+}
+
+// NewDiff returns the diff command.
+func NewDiff() Command {
+	return &diffCommand{}
+}
+
+func (c *diffCommand) Name() string {
+	return "diff"
+}
+
+func (c *diffCommand) Synopsis() string {
+	return "Prints how the values of the first target differ from the others, or how targets differ from a desired config"
+}
+
+func (c *diffCommand) SetFlags(fs *flag.FlagSet) {
+	c.setRequestFlags(fs)
+	fs.StringVar(&c.desired, "desired", "", "IETF JSON file holding the desired config of the -xpath subtree, root if not set, to compare each target with instead of the first target.")
+	fs.BoolVar(&c.apply, "apply", false, "With -desired, send the SetRequest converging each target to the desired config.")
+}
+
+func (c *diffCommand) Run(ctx context.Context, clients []*Client) error {
+	if c.desired != "" {
+		return c.converge(ctx, clients)
+	}
+	if c.apply {
+		return errors.New("-apply requires -desired")
+	}
+	if len(clients) < 2 {
+		return fmt.Errorf("diff needs at least 2 targets, got %d", len(clients))
+	}
+	getRequest, err := c.getRequest()
+	if err != nil {
+		return err
+	}
+	if err := checkTargets(ctx, clients, getRequirements(getRequest)); err != nil {
+		return err
+	}
+	var values []map[string]string
+	for _, client := range clients {
+		ctx, cancel := requestContext(ctx)
+		getResponse, err := client.Get(ctx, getRequest)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("%s: Get failed: %v", client.Target.Name, err)
+		}
+		values = append(values, leafValues(getResponse.GetNotification()))
+	}
+	differ := false
+	for i := 1; i < len(clients); i++ {
+		if printDiff(os.Stdout, clients[0].Target.Name, clients[i].Target.Name, values[0], values[i]) {
+			differ = true
+		}
+	}
+	if differ {
+		return errTargetsDiffer
+	}
+	return nil
+}
+
+// leafValues returns the values of the updates of notifications by xpath.
+func leafValues(notifications []*pb.Notification) map[string]string {
+	values := map[string]string{}
+	for _, n := range notifications {
+		for _, u := range n.GetUpdate() {
+			values[xpath.ToXPath(n.GetPrefix(), u.GetPath())] = valueString(u.GetVal())
+		}
+	}
+	return values
+}
+
+// printDiff prints the values of paths differing between targets a and b,
+// returning true if any differ.
+func printDiff(w io.Writer, a, b string, aValues, bValues map[string]string) bool {
+	paths := map[string]bool{}
+	for p := range aValues {
+		paths[p] = true
+	}
+	for p := range bValues {
+		paths[p] = true
+	}
+	var sorted []string
+	for p := range paths {
+		aValue, inA := aValues[p]
+		bValue, inB := bValues[p]
+		if inA != inB || aValue != bValue {
+			sorted = append(sorted, p)
+		}
+	}
+	if len(sorted) == 0 {
+		return false
+	}
+	sort.Strings(sorted)
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", a, b)
+	for _, p := range sorted {
+		if v, ok := aValues[p]; ok {
+			fmt.Fprintf(w, "- %s: %s\n", p, v)
+		}
+		if v, ok := bValues[p]; ok {
+			fmt.Fprintf(w, "+ %s: %s\n", p, v)
+		}
+	}
+	return true
+}
+
+// converge compares the subtree of every target with the desired config, and
+// converges the target if -apply is set.
+func (c *diffCommand) converge(ctx context.Context, clients []*Client) error {
+	getRequest, err := c.getRequest()
+	if err != nil {
+		return err
+	}
+	switch len(getRequest.Path) {
+	case 0:
+		getRequest.Path = []*pb.Path{{}}
+	case 1:
+	default:
+		return fmt.Errorf("-desired needs a single path, got %d", len(getRequest.Path))
+	}
+	if getRequest.Encoding != pb.Encoding_JSON_IETF {
+		return errors.New("-desired needs the JSON_IETF encoding")
+	}
+	if err := checkTargets(ctx, clients, getRequirements(getRequest)); err != nil {
+		return err
+	}
+	base := joinPaths(getRequest.Prefix, getRequest.Path[0])
+	b, err := ioutil.ReadFile(c.desired)
+	if err != nil {
+		return fmt.Errorf("cannot read data from file %v", c.desired)
+	}
+	desired, err := configTree([]*pb.Notification{{Update: []*pb.Update{{
+		Path: base,
+		Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: bytes.TrimSpace(b)}},
+	}}}})
+	if err != nil {
+		return fmt.Errorf("invalid desired config: %v", err)
+	}
+	desiredValues, err := configLeaves(desired)
+	if err != nil {
+		return err
+	}
+	differ := false
+	err = forEach(clients, false, func(client *Client) error {
+		getCtx, cancel := requestContext(ctx)
+		getResponse, err := client.Get(getCtx, getRequest)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("Get failed: %v", err)
+		}
+		current, err := configTree(getResponse.GetNotification())
+		if err != nil {
+			return fmt.Errorf("invalid config: %v", err)
+		}
+		currentValues, err := configLeaves(current)
+		if err != nil {
+			return err
+		}
+		if !printDiff(os.Stdout, client.Target.Name, c.desired, currentValues, desiredValues) {
+			return nil
+		}
+		setRequest, err := convergeRequest(base, current, desired)
+		if err != nil || setRequest == nil {
+			return err
+		}
+		if !c.apply {
+			differ = true
+			return nil
+		}
+		fmt.Println("== SetRequest:\n", proto.MarshalTextString(setRequest))
+		setCtx, cancel := requestContext(ctx)
+		defer cancel()
+		setResponse, err := client.Set(setCtx, setRequest)
+		if err != nil {
+			return fmt.Errorf("Set failed: %v", err)
+		}
+		fmt.Println("== SetResponse:\n", proto.MarshalTextString(setResponse))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if differ {
+		return errTargetsDiffer
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestLeafValues(t *testing.T) {
+	notifications := []*pb.Notification{{
+		Prefix: &pb.Path{Elem: []*pb.PathElem{{Name: "system"}}},
+		Update: []*pb.Update{
+			{
+				Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}}},
+				Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"hostname": "a", "domain-name": "b"}`)}},
+			},
+			{
+				Path: &pb.Path{Elem: []*pb.PathElem{{Name: "clock"}, {Name: "timezone-name"}}},
+				Val:  &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "UTC"}},
+			},
+		},
+	}}
+	got := leafValues(notifications)
+	want := map[string]string{
+		"/system/config":              `{"domain-name":"b","hostname":"a"}`,
+		"/system/clock/timezone-name": "UTC",
+	}
+	for path, value := range want {
+		if got[path] != value {
+			t.Errorf("leafValues: got %s: %s, want %s", path, got[path], value)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("leafValues: got %d values, want %d", len(got), len(want))
+	}
+}
+
+func TestValueString(t *testing.T) {
+	tests := []struct {
+		val  *pb.TypedValue
+		want string
+	}{
+		{&pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 42}}, "42"},
+		{&pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: true}}, "true"},
+		{&pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: &pb.Decimal64{Digits: 1234, Precision: 2}}}, "12.34"},
+		{&pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: &pb.Decimal64{Digits: -5, Precision: 3}}}, "-0.005"},
+		{&pb.TypedValue{Value: &pb.TypedValue_LeaflistVal{LeaflistVal: &pb.ScalarArray{Element: []*pb.TypedValue{
+			{Value: &pb.TypedValue_StringVal{StringVal: "a"}},
+			{Value: &pb.TypedValue_IntVal{IntVal: -1}},
+		}}}}, `["a","-1"]`},
+	}
+	for _, test := range tests {
+		if got := valueString(test.val); got != test.want {
+			t.Errorf("valueString(%v): got %s, want %s", test.val, got, test.want)
+		}
+	}
+}
+
+func TestPrintDiff(t *testing.T) {
+	a := map[string]string{"/a": "1", "/b": "2", "/c": "3"}
+	b := map[string]string{"/a": "1", "/b": "4", "/d": "5"}
+	var out bytes.Buffer
+	if !printDiff(&out, "lab1", "lab2", a, b) {
+		t.Error("printDiff: got no difference")
+	}
+	want := "--- lab1\n+++ lab2\n- /b: 2\n+ /b: 4\n- /c: 3\n+ /d: 5\n"
+	if out.String() != want {
+		t.Errorf("printDiff: got\n%s\nwant\n%s", out.String(), want)
+	}
+	out.Reset()
+	if printDiff(&out, "lab1", "lab2", a, a) || out.Len() != 0 {
+		t.Errorf("printDiff: got difference for equal values: %s", out.String())
+	}
+}
@@ -0,0 +1,343 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Output formats of responses.
+const (
+	// formatProto prints responses in protobuf text format, with their raw Path
+	// messages. It is the default and the only format not resolving xpaths, to
+	// keep the output of the gnmi_get and gnmi_subscribe binaries unchanged.
+	formatProto = "proto"
+	// formatProtoXPaths prints responses in protobuf text format, preceded by
+	// the xpaths of their updates and deletes as comments.
+	formatProtoXPaths = "proto_xpaths"
+	// formatJSON prints responses in protobuf JSON format, wrapped in an object
+	// holding the xpaths of their updates and deletes.
+	formatJSON = "json"
+	// formatFlat prints a "xpath value" line for every update and a line with
+	// only the xpath for every delete.
+	formatFlat = "flat"
+	// formatJSONL prints a JSON object per line for every update, delete and
+	// sync response.
+	formatJSONL = "jsonl"
+)
+
+var formats = []string{formatProto, formatProtoXPaths, formatJSON, formatFlat, formatJSONL}
+
+// formatFlagUsage is the usage of the -format flag.
+var formatFlagUsage = "Output format of responses, one of: " + strings.Join(formats, ", ") + ". All but proto, kept unchanged for backward compatibility, resolve paths to xpaths"
+
+// printer prints responses in a format.
+type printer struct {
+	w      io.Writer
+	format string
+	// target is the name of the target of the responses, set in JSON lines.
+	target string
+	// tag is set to also tag the other formats with the target, when printing
+	// responses of several targets.
+	tag bool
+}
+
+// checkFormat checks format is a known format.
+func checkFormat(format string) error {
+	for _, f := range formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown format %q, %s", format, formatFlagUsage)
+}
+
+// newPrinter returns a printer of responses from target in format, tagging
+// them with target if tag is set.
+func newPrinter(w io.Writer, format, target string, tag bool) *printer {
+	return &printer{w: w, format: format, target: target, tag: tag}
+}
+
+// structured returns true for formats meant for scripts, which requests are not
+// printed with.
+func (p *printer) structured() bool {
+	return p.format != formatProto && p.format != formatProtoXPaths
+}
+
+// request prints a request, only in the protobuf text formats.
+func (p *printer) request(name string, req proto.Message) {
+	if p.structured() {
+		log.V(1).Infof("%s:\n%s", name, proto.MarshalTextString(req))
+		return
+	}
+	fmt.Fprintf(p.w, "== %s:\n %s\n", name, proto.MarshalTextString(req))
+}
+
+// getResponse prints a GetResponse.
+func (p *printer) getResponse(resp *pb.GetResponse) error {
+	return p.response("== GetResponse:", resp, resp.GetNotification())
+}
+
+// subscribeResponse prints a SubscribeResponse holding an update or a sync
+// response.
+func (p *printer) subscribeResponse(resp *pb.SubscribeResponse) error {
+	if resp.GetSyncResponse() {
+		if p.format == formatJSONL {
+			return p.jsonLine(jsonLine{Target: p.target, SyncResponse: true})
+		}
+		if p.tag {
+			log.Infof("SyncResponse received from %s", p.target)
+		} else {
+			log.Info("SyncResponse received")
+		}
+		return nil
+	}
+	var notifications []*pb.Notification
+	if n := resp.GetUpdate(); n != nil {
+		notifications = append(notifications, n)
+	}
+	return p.response("==>", resp, notifications)
+}
+
+// response prints a response holding notifications.
+func (p *printer) response(header string, resp proto.Message, notifications []*pb.Notification) error {
+	switch p.format {
+	case formatProto, formatProtoXPaths:
+		if p.tag {
+			header = fmt.Sprintf("== Target %s\n%s", p.target, header)
+		}
+		var comments strings.Builder
+		if p.format == formatProtoXPaths {
+			for _, path := range notificationPaths(notifications) {
+				comments.WriteString("# " + path + "\n")
+			}
+		}
+		_, err := fmt.Fprintf(p.w, "%s\n%s %s\n", header, comments.String(), proto.MarshalTextString(resp))
+		return err
+	case formatJSON:
+		b, err := protojson.MarshalOptions{Indent: "  "}.Marshal(proto.MessageV2(resp))
+		if err != nil {
+			return err
+		}
+		envelope := struct {
+			Target   string          `json:"target,omitempty"`
+			XPaths   []string        `json:"xpaths"`
+			Response json.RawMessage `json:"response"`
+		}{XPaths: notificationPaths(notifications), Response: b}
+		if p.tag {
+			envelope.Target = p.target
+		}
+		if b, err = json.MarshalIndent(envelope, "", "  "); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(p.w, string(b))
+		return err
+	case formatFlat:
+		for _, n := range notifications {
+			prefix := ""
+			if t := p.targetOf(n); p.tag || t != p.target {
+				prefix = t + " "
+			}
+			for _, d := range n.GetDelete() {
+				if _, err := fmt.Fprintln(p.w, prefix+xpath.ToXPath(n.GetPrefix(), d)); err != nil {
+					return err
+				}
+			}
+			for _, u := range n.GetUpdate() {
+				if _, err := fmt.Fprintf(p.w, "%s%s %s\n", prefix, xpath.ToXPath(n.GetPrefix(), u.GetPath()), valueString(u.GetVal())); err != nil {
+					return err
+				}
+			}
+		}
+	case formatJSONL:
+		for _, n := range notifications {
+			line := jsonLine{Target: p.targetOf(n), Timestamp: n.GetTimestamp()}
+			if n.GetTimestamp() != 0 {
+				line.Time = time.Unix(0, n.GetTimestamp()).UTC().Format(time.RFC3339Nano)
+			}
+			for _, d := range n.GetDelete() {
+				line.Path, line.Delete = xpath.ToXPath(n.GetPrefix(), d), true
+				if err := p.jsonLine(line); err != nil {
+					return err
+				}
+			}
+			line.Delete = false
+			for _, u := range n.GetUpdate() {
+				line.Path, line.Value = xpath.ToXPath(n.GetPrefix(), u.GetPath()), jsonValue(u.GetVal())
+				if err := p.jsonLine(line); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// targetOf returns the target of the prefix of a notification, like the
+// targets of the values of a cache, or the target of the printer.
+func (p *printer) targetOf(n *pb.Notification) string {
+	if t := n.GetPrefix().GetTarget(); t != "" {
+		return t
+	}
+	return p.target
+}
+
+// jsonLine is a line of the JSON lines format.
+type jsonLine struct {
+	Target       string      `json:"target,omitempty"`
+	Timestamp    int64       `json:"timestamp,omitempty"`
+	Time         string      `json:"time,omitempty"`
+	Path         string      `json:"path,omitempty"`
+	Value        interface{} `json:"value,omitempty"`
+	Delete       bool        `json:"delete,omitempty"`
+	SyncResponse bool        `json:"sync_response,omitempty"`
+}
+
+func (p *printer) jsonLine(line jsonLine) error {
+	b, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.w, string(b))
+	return err
+}
+
+// notificationPaths returns the xpaths of the deletes and updates of
+// notifications.
+func notificationPaths(notifications []*pb.Notification) []string {
+	paths := []string{}
+	for _, n := range notifications {
+		for _, d := range n.GetDelete() {
+			paths = append(paths, xpath.ToXPath(n.GetPrefix(), d))
+		}
+		for _, u := range n.GetUpdate() {
+			paths = append(paths, xpath.ToXPath(n.GetPrefix(), u.GetPath()))
+		}
+	}
+	return paths
+}
+
+// jsonValue returns a value to be marshaled to JSON: JSON values as they are,
+// numbers and booleans as JSON numbers and booleans, and other values as their
+// string representation.
+func jsonValue(val *pb.TypedValue) interface{} {
+	switch v := val.GetValue().(type) {
+	case *pb.TypedValue_IntVal:
+		return v.IntVal
+	case *pb.TypedValue_UintVal:
+		return v.UintVal
+	case *pb.TypedValue_BoolVal:
+		return v.BoolVal
+	case *pb.TypedValue_FloatVal:
+		return v.FloatVal
+	case *pb.TypedValue_DoubleVal:
+		return v.DoubleVal
+	case *pb.TypedValue_DecimalVal:
+		return json.Number(decimalString(v.DecimalVal))
+	case *pb.TypedValue_LeaflistVal:
+		elements := []interface{}{}
+		for _, e := range v.LeaflistVal.GetElement() {
+			elements = append(elements, jsonValue(e))
+		}
+		return elements
+	case *pb.TypedValue_JsonIetfVal:
+		if json.Valid(v.JsonIetfVal) {
+			return json.RawMessage(v.JsonIetfVal)
+		}
+	case *pb.TypedValue_JsonVal:
+		if json.Valid(v.JsonVal) {
+			return json.RawMessage(v.JsonVal)
+		}
+	}
+	return valueString(val)
+}
+
+// valueString returns the string representation of a value, JSON values with
+// sorted keys so that equal values compare equal.
+func valueString(val *pb.TypedValue) string {
+	var b []byte
+	switch v := val.GetValue().(type) {
+	case *pb.TypedValue_StringVal:
+		return v.StringVal
+	case *pb.TypedValue_AsciiVal:
+		return v.AsciiVal
+	case *pb.TypedValue_IntVal:
+		return strconv.FormatInt(v.IntVal, 10)
+	case *pb.TypedValue_UintVal:
+		return strconv.FormatUint(v.UintVal, 10)
+	case *pb.TypedValue_BoolVal:
+		return strconv.FormatBool(v.BoolVal)
+	case *pb.TypedValue_FloatVal:
+		return strconv.FormatFloat(float64(v.FloatVal), 'g', -1, 32)
+	case *pb.TypedValue_DoubleVal:
+		return strconv.FormatFloat(v.DoubleVal, 'g', -1, 64)
+	case *pb.TypedValue_DecimalVal:
+		return decimalString(v.DecimalVal)
+	case *pb.TypedValue_BytesVal:
+		return base64.StdEncoding.EncodeToString(v.BytesVal)
+	case *pb.TypedValue_LeaflistVal:
+		elements := []string{}
+		for _, e := range v.LeaflistVal.GetElement() {
+			elements = append(elements, valueString(e))
+		}
+		b, _ = json.Marshal(elements)
+		return string(b)
+	case *pb.TypedValue_JsonIetfVal:
+		b = v.JsonIetfVal
+	case *pb.TypedValue_JsonVal:
+		b = v.JsonVal
+	default:
+		return strings.TrimSpace(proto.CompactTextString(val))
+	}
+	var tree interface{}
+	if err := json.Unmarshal(b, &tree); err != nil {
+		return string(b)
+	}
+	canonical, err := json.Marshal(tree)
+	if err != nil {
+		return string(b)
+	}
+	return string(canonical)
+}
+
+// decimalString returns the string representation of a decimal64 value.
+func decimalString(d *pb.Decimal64) string {
+	digits := strconv.FormatInt(d.GetDigits(), 10)
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+	precision := int(d.GetPrecision())
+	if precision == 0 {
+		return sign + digits
+	}
+	if len(digits) <= precision {
+		digits = strings.Repeat("0", precision-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-precision] + "." + digits[len(digits)-precision:]
+}
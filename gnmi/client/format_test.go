@@ -0,0 +1,166 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+var testNotification = &pb.Notification{
+	Timestamp: 1600000000000000000,
+	Prefix:    &pb.Path{Elem: []*pb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth0"}}}},
+	Update: []*pb.Update{
+		{
+			Path: &pb.Path{Elem: []*pb.PathElem{{Name: "state"}, {Name: "mtu"}}},
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1500}},
+		},
+		{
+			Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}}},
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"enabled": true}`)}},
+		},
+	},
+	Delete: []*pb.Path{{Elem: []*pb.PathElem{{Name: "state"}, {Name: "description"}}}},
+}
+
+func TestPrintGetResponse(t *testing.T) {
+	tests := []struct {
+		format string
+		tag    bool
+		want   []string
+	}{
+		{
+			format: formatProto,
+			want:   []string{"== GetResponse:\n notification: <\n"},
+		},
+		{
+			format: formatProtoXPaths,
+			want: []string{
+				"== GetResponse:\n# /interfaces/interface[name=eth0]/state/description\n# /interfaces/interface[name=eth0]/state/mtu\n# /interfaces/interface[name=eth0]/config\n",
+				"uint_val: 1500",
+			},
+		},
+		{
+			format: formatJSON,
+			want: []string{
+				`"xpaths": [`,
+				`"/interfaces/interface[name=eth0]/state/mtu",`,
+				`"uintVal": "1500"`,
+			},
+		},
+		{
+			format: formatFlat,
+			want: []string{
+				"/interfaces/interface[name=eth0]/state/description\n" +
+					"/interfaces/interface[name=eth0]/state/mtu 1500\n" +
+					"/interfaces/interface[name=eth0]/config {\"enabled\":true}\n",
+			},
+		},
+		{
+			format: formatFlat,
+			tag:    true,
+			want:   []string{"lab1 /interfaces/interface[name=eth0]/state/mtu 1500\n"},
+		},
+		{
+			format: formatJSONL,
+			want: []string{
+				`{"target":"lab1","timestamp":1600000000000000000,"time":"2020-09-13T12:26:40Z","path":"/interfaces/interface[name=eth0]/state/description","delete":true}` + "\n" +
+					`{"target":"lab1","timestamp":1600000000000000000,"time":"2020-09-13T12:26:40Z","path":"/interfaces/interface[name=eth0]/state/mtu","value":1500}` + "\n" +
+					`{"target":"lab1","timestamp":1600000000000000000,"time":"2020-09-13T12:26:40Z","path":"/interfaces/interface[name=eth0]/config","value":{"enabled":true}}` + "\n",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := newPrinter(&out, test.format, "lab1", test.tag).getResponse(&pb.GetResponse{Notification: []*pb.Notification{testNotification}}); err != nil {
+				t.Fatalf("getResponse failed: %v", err)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("getResponse: got\n%s\nwant it to contain\n%s", out.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestPrintProtoUnchanged(t *testing.T) {
+	resp := &pb.GetResponse{Notification: []*pb.Notification{testNotification}}
+	var out bytes.Buffer
+	if err := newPrinter(&out, formatProto, "lab1", false).getResponse(resp); err != nil {
+		t.Fatalf("getResponse failed: %v", err)
+	}
+	// The default format prints responses like earlier releases did.
+	var want bytes.Buffer
+	fmt.Fprintln(&want, "== GetResponse:\n", proto.MarshalTextString(resp))
+	if out.String() != want.String() {
+		t.Errorf("getResponse: got\n%s\nwant\n%s", out.String(), want.String())
+	}
+}
+
+func TestPrintSyncResponse(t *testing.T) {
+	var out bytes.Buffer
+	sync := &pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}}
+	if err := newPrinter(&out, formatJSONL, "lab1", false).subscribeResponse(sync); err != nil {
+		t.Fatalf("subscribeResponse failed: %v", err)
+	}
+	if want := `{"target":"lab1","sync_response":true}` + "\n"; out.String() != want {
+		t.Errorf("subscribeResponse: got %s, want %s", out.String(), want)
+	}
+	out.Reset()
+	if err := newPrinter(&out, formatFlat, "lab1", false).subscribeResponse(sync); err != nil || out.Len() != 0 {
+		t.Errorf("subscribeResponse: got (%q, %v), want nothing printed", out.String(), err)
+	}
+}
+
+func TestCheckFormat(t *testing.T) {
+	for _, format := range formats {
+		if err := checkFormat(format); err != nil {
+			t.Errorf("checkFormat(%s): %v", format, err)
+		}
+	}
+	if err := checkFormat("xml"); err == nil {
+		t.Error("checkFormat(xml): got nil error")
+	}
+}
+
+func TestPrintPrefixTarget(t *testing.T) {
+	n := &pb.Notification{
+		Prefix: &pb.Path{Target: "lab2"},
+		Update: []*pb.Update{{
+			Path: &pb.Path{Elem: []*pb.PathElem{{Name: "a"}}},
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1}},
+		}},
+	}
+	for format, want := range map[string]string{
+		formatFlat:  "lab2 /a 1\n",
+		formatJSONL: `{"target":"lab2","path":"/a","value":1}` + "\n",
+	} {
+		var out bytes.Buffer
+		if err := newPrinter(&out, format, "lab1", false).getResponse(&pb.GetResponse{Notification: []*pb.Notification{n}}); err != nil {
+			t.Fatalf("getResponse failed: %v", err)
+		}
+		if out.String() != want {
+			t.Errorf("getResponse in %s: got %q, want %q", format, out.String(), want)
+		}
+	}
+}
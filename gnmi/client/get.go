@@ -0,0 +1,112 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// getCommand gets paths from targets.
+type getCommand struct {
+	xPathFlags       arrayFlags
+	pbPathFlags      arrayFlags
+	pbModelDataFlags arrayFlags
+	encodingName     string
+	prefix           string
+	prefixTarget     string
+	dataType         int
+	format           string
+}
+
+// NewGet returns the get command.
+func NewGet() Command {
+	return &getCommand{}
+}
+
+func (c *getCommand) Name() string {
+	return "get"
+}
+
+func (c *getCommand) Synopsis() string {
+	return "Gets paths from targets"
+}
+
+func (c *getCommand) SetFlags(fs *flag.FlagSet) {
+	c.setRequestFlags(fs)
+	fs.StringVar(&c.format, "format", formatProto, formatFlagUsage)
+}
+
+// setRequestFlags registers the flags of the GetRequest.
+func (c *getCommand) setRequestFlags(fs *flag.FlagSet) {
+	fs.Var(&c.xPathFlags, "xpath", "xpath of the config node to be fetched")
+	fs.Var(&c.pbPathFlags, "pbpath", "protobuf format path of the config node to be fetched")
+	fs.Var(&c.pbModelDataFlags, "model_data", "Data models to be used by the target in the format of 'name,organization,version'")
+	fs.StringVar(&c.encodingName, "encoding", "JSON_IETF", "value encoding format to be used")
+	fs.StringVar(&c.prefix, "prefix", "", "prefix for the path. this is optional. valid values: oc, srl.")
+	fs.StringVar(&c.prefixTarget, "prefix_target", "", "target of the prefix, selecting a target behind a proxy or cache. this is optional.")
+	fs.IntVar(&c.dataType, "data_type", 0, "dataType - 0 (ALL), 1 (CONFIG), 2 (STATE) 3 (OPERATIONAL). Default is 0.")
+}
+
+// getRequest builds the GetRequest from the flags.
+func (c *getCommand) getRequest() (*pb.GetRequest, error) {
+	encoding, err := parseEncoding(c.encodingName)
+	if err != nil {
+		return nil, err
+	}
+	pbPathList, err := parsePaths(c.xPathFlags, c.pbPathFlags)
+	if err != nil {
+		return nil, err
+	}
+	pbModelDataList, err := parseModelData(c.pbModelDataFlags)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRequest{
+		Encoding:  encoding,
+		Path:      pbPathList,
+		UseModels: pbModelDataList,
+		Prefix:    &pb.Path{Origin: c.prefix, Target: c.prefixTarget},
+		Type:      pb.GetRequest_DataType(c.dataType),
+	}, nil
+}
+
+func (c *getCommand) Run(ctx context.Context, clients []*Client) error {
+	if err := checkFormat(c.format); err != nil {
+		return err
+	}
+	getRequest, err := c.getRequest()
+	if err != nil {
+		return err
+	}
+	if err := checkTargets(ctx, clients, getRequirements(getRequest)); err != nil {
+		return err
+	}
+	newPrinter(os.Stdout, c.format, "", false).request("GetRequest", getRequest)
+	return forEach(clients, false, func(client *Client) error {
+		ctx, cancel := requestContext(ctx)
+		defer cancel()
+		getResponse, err := client.Get(ctx, getRequest)
+		if err != nil {
+			return fmt.Errorf("Get failed: %v", err)
+		}
+		return newPrinter(os.Stdout, c.format, client.Target.Name, len(clients) > 1).getResponse(getResponse)
+	})
+}
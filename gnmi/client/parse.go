@@ -0,0 +1,92 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+type arrayFlags []string
+
+func (i *arrayFlags) String() string {
+	return ""
+}
+
+func (i *arrayFlags) Set(value string) error {
+	*i = append(*i, value)
+	return nil
+}
+
+// parsePaths parses xpaths and protobuf text format paths into gNMI paths.
+func parsePaths(xPathFlags, pbPathFlags arrayFlags) ([]*pb.Path, error) {
+	var pbPathList []*pb.Path
+	for _, xPath := range xPathFlags {
+		pbPath, err := xpath.ToGNMIPath(xPath)
+		if err != nil {
+			return nil, fmt.Errorf("error in parsing xpath %q to gnmi path", xPath)
+		}
+		pbPathList = append(pbPathList, pbPath)
+	}
+	for _, textPbPath := range pbPathFlags {
+		var pbPath pb.Path
+		if err := proto.UnmarshalText(textPbPath, &pbPath); err != nil {
+			return nil, fmt.Errorf("error in unmarshaling %q to gnmi Path", textPbPath)
+		}
+		pbPathList = append(pbPathList, &pbPath)
+	}
+	return pbPathList, nil
+}
+
+// parseModelData parses models in the format of 'name,organization,version'.
+func parseModelData(pbModelDataFlags arrayFlags) ([]*pb.ModelData, error) {
+	var pbModelDataList []*pb.ModelData
+
+	for _, textPbModelData := range pbModelDataFlags {
+		modelDataVars := strings.Split(textPbModelData, ",")
+		if len(modelDataVars) != 3 {
+			return pbModelDataList, fmt.Errorf("Unable to parse string")
+		}
+		pbModelData := &pb.ModelData{
+			Name:         modelDataVars[0],
+			Organization: modelDataVars[1],
+			Version:      modelDataVars[2],
+		}
+		pbModelDataList = append(pbModelDataList, pbModelData)
+	}
+
+	return pbModelDataList, nil
+}
+
+// parseEncoding parses the name of a gNMI encoding.
+func parseEncoding(encodingFormat string) (pb.Encoding, error) {
+	encoding, ok := pb.Encoding_value[encodingFormat]
+	if !ok {
+		var encodingList []string
+		for _, name := range pb.Encoding_name {
+			encodingList = append(encodingList, name)
+		}
+		sort.Strings(encodingList)
+		return 0, errors.New("supported encodings: " + strings.Join(encodingList, ", "))
+	}
+	return pb.Encoding(encoding), nil
+}
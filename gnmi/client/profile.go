@@ -0,0 +1,170 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/google/gnxi/utils/credentials"
+	"google.golang.org/grpc"
+	grpcCreds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v2"
+)
+
+// Metadata keys of the username and password, as checked by
+// credentials.AuthorizeUser on the target.
+const (
+	usernameKey = "username"
+	passwordKey = "password"
+)
+
+// Target holds how to connect to a gNMI target.
+type Target struct {
+	// Name is the name of the target in the profile, or its address.
+	Name string `yaml:"-"`
+	// Address of the target in the format of host:port.
+	Address string `yaml:"address"`
+	// TargetName is the hostname verified by the TLS handshake, the host of
+	// Address if empty.
+	TargetName string `yaml:"target_name"`
+	// CA, Cert and Key are the CA certificate, client certificate and client
+	// private key files.
+	CA   string `yaml:"ca"`
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+	// Insecure skips TLS validation and NoTLS disables TLS.
+	Insecure bool `yaml:"insecure"`
+	NoTLS    bool `yaml:"notls"`
+	// Username and Password are sent in the metadata of every RPC if set.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+
+	// fromFlags is set for the target configured by the connection flags.
+	fromFlags bool
+}
+
+// Profile is a connection profile, holding named targets.
+type Profile struct {
+	// Default is the name of the target used when none is selected.
+	Default string             `yaml:"default"`
+	Targets map[string]*Target `yaml:"targets"`
+}
+
+// LoadProfile loads a connection profile from a YAML or JSON file.
+func LoadProfile(file string) (*Profile, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	p := &Profile{}
+	if err = yaml.UnmarshalStrict(b, p); err != nil {
+		return nil, fmt.Errorf("failed to parse profile %s: %v", file, err)
+	}
+	for name, t := range p.Targets {
+		if t == nil || t.Address == "" {
+			return nil, fmt.Errorf("target %q in profile %s has no address", name, file)
+		}
+		t.Name = name
+	}
+	return p, nil
+}
+
+// Select returns the targets named, all targets for "all", or the default
+// target if none are named.
+func (p *Profile) Select(names []string) ([]*Target, error) {
+	switch {
+	case len(names) == 1 && names[0] == "all":
+		names = nil
+		for name := range p.Targets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	case len(names) == 0 && p.Default != "":
+		names = []string{p.Default}
+	case len(names) == 0 && len(p.Targets) == 1:
+		for name := range p.Targets {
+			names = []string{name}
+		}
+	case len(names) == 0:
+		return nil, fmt.Errorf("profile has %d targets and no default, select targets with -target", len(p.Targets))
+	}
+	var targets []*Target
+	for _, name := range names {
+		t, ok := p.Targets[name]
+		if !ok {
+			return nil, fmt.Errorf("no target %q in profile", name)
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// flagTarget returns the target configured by the -target_addr and credentials
+// flags.
+func flagTarget() *Target {
+	return &Target{Name: *targetAddr, Address: *targetAddr, fromFlags: true}
+}
+
+// dialOptions returns the gRPC DialOptions of the transport credentials.
+func (t *Target) dialOptions() ([]grpc.DialOption, error) {
+	if t.fromFlags {
+		return credentials.ClientCredentials(), nil
+	}
+	if t.NoTLS {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+	tlsConfig := &tls.Config{ServerName: t.TargetName}
+	if t.Insecure {
+		tlsConfig.InsecureSkipVerify = true
+	} else {
+		if t.CA == "" {
+			return nil, fmt.Errorf("target %q has no CA certificate", t.Name)
+		}
+		ca, err := ioutil.ReadFile(t.CA)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA certificate: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates in %s", t.CA)
+		}
+	}
+	if t.Cert != "" || t.Key != "" {
+		certificate, err := tls.LoadX509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, fmt.Errorf("could not load key/certificate pair from files: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(grpcCreds.NewTLS(tlsConfig))}, nil
+}
+
+// attach attaches the user credentials of the target to a context.
+func (t *Target) attach(ctx context.Context) context.Context {
+	if t.fromFlags {
+		return credentials.AttachToContext(ctx)
+	}
+	if t.Username == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, usernameKey, t.Username, passwordKey, t.Password)
+}
@@ -0,0 +1,136 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"google.golang.org/grpc/metadata"
+)
+
+const testProfile = `
+default: lab1
+targets:
+  lab1:
+    address: localhost:9339
+    target_name: target.com
+    ca: ca.crt
+    username: admin
+    password: secret
+  lab2:
+    address: localhost:9340
+    notls: true
+`
+
+func TestLoadProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"YAML", testProfile, false},
+		{"JSON", `{"targets": {"lab1": {"address": "localhost:9339", "notls": true}}}`, false},
+		{"Target without address", "targets:\n  lab1:\n    notls: true\n", true},
+		{"Unknown field", "targets:\n  lab1:\n    adress: localhost:9339\n", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := LoadProfile(writeTempFile(t, "profile.yaml", test.content))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LoadProfile: got error %v, want error %v", err, test.wantErr)
+			}
+			if err == nil && p.Targets["lab1"].Name != "lab1" {
+				t.Errorf("LoadProfile: got target name %q, want lab1", p.Targets["lab1"].Name)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	p, err := LoadProfile(writeTempFile(t, "profile.yaml", testProfile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		profile *Profile
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{"Default", p, nil, []string{"lab1"}, false},
+		{"Named", p, []string{"lab2", "lab1"}, []string{"lab2", "lab1"}, false},
+		{"All", p, []string{"all"}, []string{"lab1", "lab2"}, false},
+		{"Unknown", p, []string{"lab3"}, nil, true},
+		{"Single target", &Profile{Targets: map[string]*Target{"lab2": p.Targets["lab2"]}}, nil, []string{"lab2"}, false},
+		{"No default", &Profile{Targets: p.Targets}, nil, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			targets, err := test.profile.Select(test.names)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Select(%v): got error %v, want error %v", test.names, err, test.wantErr)
+			}
+			var got []string
+			for _, target := range targets {
+				got = append(got, target.Name)
+			}
+			if diff := pretty.Compare(test.want, got); diff != "" {
+				t.Errorf("Select(%v): (-want +got)\n%s", test.names, diff)
+			}
+		})
+	}
+}
+
+func TestAttach(t *testing.T) {
+	p, err := LoadProfile(writeTempFile(t, "profile.yaml", testProfile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, _ := metadata.FromOutgoingContext(p.Targets["lab1"].attach(context.Background()))
+	if got := md.Get(usernameKey); len(got) != 1 || got[0] != "admin" {
+		t.Errorf("got username %v, want admin", got)
+	}
+	if got := md.Get(passwordKey); len(got) != 1 || got[0] != "secret" {
+		t.Errorf("got password %v, want secret", got)
+	}
+	if _, ok := metadata.FromOutgoingContext(p.Targets["lab2"].attach(context.Background())); ok {
+		t.Error("got metadata for a target without username")
+	}
+}
+
+func TestDialOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  *Target
+		wantErr bool
+	}{
+		{"No TLS", &Target{NoTLS: true}, false},
+		{"Insecure", &Target{Insecure: true}, false},
+		{"No CA", &Target{}, true},
+		{"Missing CA file", &Target{CA: "missing.crt"}, true},
+		{"Missing key", &Target{Insecure: true, Cert: "client.crt"}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.target.dialOptions(); (err != nil) != test.wantErr {
+				t.Errorf("dialOptions: got error %v, want error %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	metricGauge   = "gauge"
+	metricCounter = "counter"
+)
+
+var (
+	metricNameRE = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRE  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	invalidRE    = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+)
+
+// metricMapping names the metrics of the numeric leaves of a path.
+type metricMapping struct {
+	// Path is the xpath of a leaf, or of a subtree whose leaves are named
+	// after Name followed by their path below it. It may hold wildcards.
+	Path string `yaml:"path"`
+	Name string `yaml:"name"`
+	// Type is gauge, the default, or counter.
+	Type string `yaml:"type"`
+	Help string `yaml:"help"`
+	// Labels renames the labels of list keys, by key name.
+	Labels map[string]string `yaml:"labels"`
+
+	path *pb.Path
+}
+
+// metricsFile is the file mapping paths to metrics.
+type metricsFile struct {
+	Metrics []*metricMapping `yaml:"metrics"`
+}
+
+// loadMetricMappings loads the mappings of paths to metrics from a YAML or
+// JSON file.
+func loadMetricMappings(file string) ([]*metricMapping, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	f := &metricsFile{}
+	if err = yaml.UnmarshalStrict(b, f); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+	}
+	if len(f.Metrics) == 0 {
+		return nil, fmt.Errorf("no metrics in %s", file)
+	}
+	for i, m := range f.Metrics {
+		if err := m.init(); err != nil {
+			return nil, fmt.Errorf("metric %d in %s: %v", i+1, file, err)
+		}
+	}
+	return f.Metrics, nil
+}
+
+// init validates the mapping and parses its path.
+func (m *metricMapping) init() error {
+	if m == nil {
+		return fmt.Errorf("empty metric")
+	}
+	if !metricNameRE.MatchString(m.Name) {
+		return fmt.Errorf("invalid metric name %q", m.Name)
+	}
+	switch m.Type {
+	case "":
+		m.Type = metricGauge
+	case metricGauge, metricCounter:
+	default:
+		return fmt.Errorf("invalid type %q of %s, want gauge or counter", m.Type, m.Name)
+	}
+	for key, label := range m.Labels {
+		if !labelNameRE.MatchString(label) || label == "target" {
+			return fmt.Errorf("invalid label name %q for key %s of %s", label, key, m.Name)
+		}
+	}
+	var err error
+	if m.path, err = xpath.ToGNMIPath(m.Path); err != nil {
+		return fmt.Errorf("invalid path %q of %s: %v", m.Path, m.Name, err)
+	}
+	if m.Help == "" {
+		m.Help = "Values of " + m.Path
+	}
+	return nil
+}
+
+// metricName returns the name of the metric of path, which m.path matches:
+// m.Name followed by the names of the elements of path below those matched.
+func (m *metricMapping) metricName(path *pb.Path) string {
+	parts := []string{m.Name}
+	for _, e := range path.GetElem()[prefixLen(m.path.GetElem(), path.GetElem()):] {
+		parts = append(parts, invalidRE.ReplaceAllString(util.StripModulePrefix(e.GetName()), "_"))
+	}
+	return strings.Join(parts, "_")
+}
+
+// label is a label of a sample.
+type label struct {
+	name, value string
+}
+
+// labels returns the labels of the sample of path from target: target, then
+// the keys of the list entries of path.
+func (m *metricMapping) labels(target string, path *pb.Path) []label {
+	labels := []label{{"target", target}}
+	used := map[string]bool{"target": true}
+	for _, e := range path.GetElem() {
+		var keys []string
+		for k := range e.GetKey() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			name, ok := m.Labels[k]
+			if !ok {
+				name = invalidRE.ReplaceAllString(util.StripModulePrefix(k), "_")
+				if used[name] {
+					// Keys of nested lists often share a name.
+					name = invalidRE.ReplaceAllString(util.StripModulePrefix(e.GetName()), "_") + "_" + name
+				}
+			}
+			used[name] = true
+			labels = append(labels, label{name, e.GetKey()[k]})
+		}
+	}
+	return labels
+}
+
+// metricValue returns the value of numeric and boolean TypedValues as a
+// float.
+func metricValue(val *pb.TypedValue) (float64, bool) {
+	switch v := val.GetValue().(type) {
+	case *pb.TypedValue_IntVal:
+		return float64(v.IntVal), true
+	case *pb.TypedValue_UintVal:
+		return float64(v.UintVal), true
+	case *pb.TypedValue_FloatVal:
+		return float64(v.FloatVal), true
+	case *pb.TypedValue_DoubleVal:
+		return v.DoubleVal, true
+	case *pb.TypedValue_DecimalVal:
+		return float64(v.DecimalVal.GetDigits()) / math.Pow10(int(v.DecimalVal.GetPrecision())), true
+	case *pb.TypedValue_BoolVal:
+		if v.BoolVal {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sample is the latest value of the metric of a path.
+type sample struct {
+	mapping  *metricMapping
+	name     string
+	labels   []label
+	path     *pb.Path
+	value    float64
+	received time.Time
+}
+
+// exporter converts the numeric values of targets into metrics, exposed in
+// the Prometheus text format.
+type exporter struct {
+	mappings []*metricMapping
+	mu       sync.Mutex
+	// samples holds the samples of every target by xpath.
+	samples map[string]map[string]*sample
+}
+
+func newExporter(mappings []*metricMapping) *exporter {
+	return &exporter{mappings: mappings, samples: map[string]map[string]*sample{}}
+}
+
+// mapping returns the first mapping matching path, or nil.
+func (e *exporter) mapping(path *pb.Path) *metricMapping {
+	for _, m := range e.mappings {
+		if matchPath(m.path.GetElem(), path.GetElem()) {
+			return m
+		}
+	}
+	return nil
+}
+
+// update applies the deletes then the updates of a notification from target.
+// Values which are not numbers or booleans, or of paths no mapping matches,
+// are ignored.
+func (e *exporter) update(target string, n *pb.Notification) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	samples := e.samples[target]
+	if samples == nil {
+		samples = map[string]*sample{}
+		e.samples[target] = samples
+	}
+	for _, d := range n.GetDelete() {
+		deleted := joinPaths(n.GetPrefix(), d)
+		for x, s := range samples {
+			if matchPath(deleted.GetElem(), s.path.GetElem()) {
+				delete(samples, x)
+			}
+		}
+	}
+	now := time.Now()
+	for _, u := range n.GetUpdate() {
+		path := joinPaths(n.GetPrefix(), u.GetPath())
+		x := xpath.ToXPath(path)
+		value, ok := metricValue(u.GetVal())
+		m := e.mapping(path)
+		if !ok || m == nil {
+			// The leaf may have changed type, don't keep its former value.
+			delete(samples, x)
+			continue
+		}
+		samples[x] = &sample{mapping: m, name: m.metricName(path), labels: m.labels(target, path), path: path, value: value, received: now}
+	}
+}
+
+// purge deletes the samples of target received before since.
+func (e *exporter) purge(target string, since time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for x, s := range e.samples[target] {
+		if s.received.Before(since) {
+			delete(e.samples[target], x)
+		}
+	}
+}
+
+// write writes the samples in the Prometheus text format, grouped by metric
+// and ordered by name and labels.
+func (e *exporter) write(w io.Writer) error {
+	e.mu.Lock()
+	metrics := map[string]map[string]*sample{}
+	for _, samples := range e.samples {
+		for _, s := range samples {
+			if metrics[s.name] == nil {
+				metrics[s.name] = map[string]*sample{}
+			}
+			// Samples of different paths with the same name and labels would
+			// be rejected, keep the first path.
+			l := labelsString(s.labels)
+			if prev, ok := metrics[s.name][l]; !ok || xpath.ToXPath(s.path) < xpath.ToXPath(prev.path) {
+				metrics[s.name][l] = s
+			}
+		}
+	}
+	e.mu.Unlock()
+
+	var names []string
+	for name := range metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b bytes.Buffer
+	for _, name := range names {
+		var labels []string
+		for l := range metrics[name] {
+			labels = append(labels, l)
+		}
+		sort.Strings(labels)
+		m := metrics[name][labels[0]].mapping
+		fmt.Fprintf(&b, "# HELP %s %s\n", name, helpEscaper.Replace(m.Help))
+		fmt.Fprintf(&b, "# TYPE %s %s\n", name, m.Type)
+		for _, l := range labels {
+			fmt.Fprintf(&b, "%s%s %s\n", name, l, strconv.FormatFloat(metrics[name][l].value, 'g', -1, 64))
+		}
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// labelsString returns labels in the Prometheus text format.
+func labelsString(labels []label) string {
+	var parts []string
+	for _, l := range labels {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, l.name, labelEscaper.Replace(l.value)))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// ServeHTTP writes the metrics.
+func (e *exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := e.write(w); err != nil {
+		log.Errorf("Writing metrics failed: %v", err)
+	}
+}
+
+// serveMetrics serves the metrics of e on address at /metrics until the
+// returned function is called.
+func serveMetrics(e *exporter, address string) (func(), error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	srv := &http.Server{Handler: mux}
+	log.Infof("Serving metrics on http://%s/metrics", lis.Addr())
+	go func() {
+		if err := srv.Serve(lis); err != http.ErrServerClosed {
+			log.Errorf("Serving metrics failed: %v", err)
+		}
+	}()
+	return func() { srv.Close() }, nil
+}
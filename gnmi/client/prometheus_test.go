@@ -0,0 +1,169 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestLoadMetricMappings(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"Valid", `
+metrics:
+- path: /interfaces/interface/state/counters/in-octets
+  name: interface_in_octets_total
+  type: counter
+  labels: {name: interface}
+- path: /system/...
+  name: system
+`, ""},
+		{"No metrics", "metrics: []", "no metrics"},
+		{"Unknown field", "metrics:\n- {path: /a, name: a, unit: s}", "field unit not found"},
+		{"Invalid name", "metrics:\n- {path: /a, name: in-octets}", `invalid metric name "in-octets"`},
+		{"Invalid type", "metrics:\n- {path: /a, name: a, type: histogram}", `invalid type "histogram"`},
+		{"Invalid label", "metrics:\n- {path: /a, name: a, labels: {name: target}}", `invalid label name "target"`},
+		{"Invalid path", "metrics:\n- {path: '/a[name=b', name: a}", "invalid path"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mappings, err := loadMetricMappings(writeTempFile(t, "metrics.yaml", test.content))
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("loadMetricMappings returned error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadMetricMappings failed: %v", err)
+			}
+			if got := mappings[1].Type; got != metricGauge {
+				t.Errorf("default type is %q, want %q", got, metricGauge)
+			}
+		})
+	}
+}
+
+func TestMetricValue(t *testing.T) {
+	tests := []struct {
+		val    *pb.TypedValue
+		want   float64
+		wantOK bool
+	}{
+		{&pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: -3}}, -3, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 42}}, 42, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_FloatVal{FloatVal: 0.5}}, 0.5, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_DoubleVal{DoubleVal: 1.25}}, 1.25, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: &pb.Decimal64{Digits: 4215, Precision: 2}}}, 42.15, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: true}}, 1, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: false}}, 0, true},
+		{&pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "UP"}}, 0, false},
+	}
+	for _, test := range tests {
+		got, ok := metricValue(test.val)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("metricValue(%v) = %v, %v, want %v, %v", test.val, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestExporter(t *testing.T) {
+	mappings, err := loadMetricMappings(writeTempFile(t, "metrics.yaml", `
+metrics:
+- path: /interfaces/interface/state/counters/in-octets
+  name: interface_in_octets_total
+  type: counter
+  help: Octets received.
+  labels: {name: interface}
+- path: /interfaces/interface/subinterfaces/subinterface/state/counters
+  name: subinterface
+- path: /components/component[name=*]/state/temperature/instant
+  name: component_temperature_celsius
+`))
+	if err != nil {
+		t.Fatalf("loadMetricMappings failed: %v", err)
+	}
+	uint := func(u uint64) *pb.TypedValue { return &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: u}} }
+	e := newExporter(mappings)
+	e.update("lab1", &pb.Notification{
+		Prefix: mustPath(t, "/interfaces"),
+		Update: []*pb.Update{
+			{Path: mustPath(t, "interface[name=eth0]/state/counters/in-octets"), Val: uint(100)},
+			{Path: mustPath(t, "interface[name=eth1]/state/counters/in-octets"), Val: uint(200)},
+			{Path: mustPath(t, "interface[name=eth0]/subinterfaces/subinterface[index=0]/state/counters/in-pkts"), Val: uint(7)},
+			// Not mapped.
+			{Path: mustPath(t, "interface[name=eth0]/state/mtu"), Val: uint(1500)},
+			// Not numeric.
+			{Path: mustPath(t, "interface[name=eth0]/subinterfaces/subinterface[index=0]/state/counters/last-clear"), Val: &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "never"}}},
+		},
+	})
+	e.update("lab2", &pb.Notification{
+		Update: []*pb.Update{
+			{Path: mustPath(t, `/components/component[name=CPU "0"]/state/temperature/instant`), Val: &pb.TypedValue{Value: &pb.TypedValue_DoubleVal{DoubleVal: 42.5}}},
+			{Path: mustPath(t, "/interfaces/interface[name=eth0]/state/counters/in-octets"), Val: uint(300)},
+		},
+	})
+	e.update("lab1", &pb.Notification{
+		Timestamp: time.Now().UnixNano(),
+		Delete:    []*pb.Path{mustPath(t, "/interfaces/interface[name=eth1]")},
+	})
+
+	var b bytes.Buffer
+	if err := e.write(&b); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	want := `# HELP component_temperature_celsius Values of /components/component[name=*]/state/temperature/instant
+# TYPE component_temperature_celsius gauge
+component_temperature_celsius{target="lab2",name="CPU \"0\""} 42.5
+# HELP interface_in_octets_total Octets received.
+# TYPE interface_in_octets_total counter
+interface_in_octets_total{target="lab1",interface="eth0"} 100
+interface_in_octets_total{target="lab2",interface="eth0"} 300
+# HELP subinterface_in_pkts Values of /interfaces/interface/subinterfaces/subinterface/state/counters
+# TYPE subinterface_in_pkts gauge
+subinterface_in_pkts{target="lab1",name="eth0",index="0"} 7
+`
+	if diff := pretty.Compare(strings.Split(want, "\n"), strings.Split(b.String(), "\n")); diff != "" {
+		t.Errorf("write returned diff (-want +got):\n%s", diff)
+	}
+
+	e.purge("lab2", time.Now())
+	b.Reset()
+	if err := e.write(&b); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if strings.Contains(b.String(), "lab2") {
+		t.Errorf("samples of lab2 remain after purge:\n%s", b.String())
+	}
+}
+
+func TestMetricLabels(t *testing.T) {
+	m := &metricMapping{}
+	got := m.labels("lab1", mustPath(t, "/components/component[name=fan0]/properties/property[name=speed]/state/value"))
+	want := []label{{"target", "lab1"}, {"name", "fan0"}, {"property_name", "speed"}}
+	if diff := pretty.Compare(want, got); diff != "" {
+		t.Errorf("labels returned diff (-want +got):\n%s", diff)
+	}
+}
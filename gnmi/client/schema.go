@@ -0,0 +1,272 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/gnxi/gnmi/modeldata/gostruct"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// modelSchema is a compiled YANG schema: its schema tree, and the types of
+// its enumerations and identityrefs, whose values the tree doesn't hold.
+type modelSchema struct {
+	root      *yang.Entry
+	enumTypes map[string][]reflect.Type
+}
+
+// deviceSchema returns the schema compiled into the gostruct package.
+func deviceSchema() *modelSchema {
+	return &modelSchema{root: gostruct.SchemaTree["Device"], enumTypes: gostruct.ΛEnumTypes}
+}
+
+// find returns the schema entry of path, or nil if path is not part of the
+// schema.
+func (m *modelSchema) find(path *pb.Path) *yang.Entry {
+	if m == nil {
+		return nil
+	}
+	e := m.root
+	for _, elem := range path.GetElem() {
+		if e = schemaChild(e, util.StripModulePrefix(elem.Name)); e == nil {
+			return nil
+		}
+	}
+	return e
+}
+
+// enumNames returns the names of the values of the enumerations and
+// identityrefs of entry, or nil if they are unknown.
+func (m *modelSchema) enumNames(entry *yang.Entry) map[string]bool {
+	var names map[string]bool
+	for _, t := range m.enumTypes[util.SchemaTreePathNoModule(entry)] {
+		enum, ok := reflect.Zero(t).Interface().(ygot.GoEnum)
+		if !ok {
+			continue
+		}
+		for _, def := range enum.ΛMap()[t.Name()] {
+			if names == nil {
+				names = map[string]bool{}
+			}
+			names[def.Name] = true
+		}
+	}
+	return names
+}
+
+// schemaChild returns the child of e named name, looking through choice and
+// case statements, which are not part of data paths.
+func schemaChild(e *yang.Entry, name string) *yang.Entry {
+	if c, ok := e.Dir[name]; ok && !util.IsChoiceOrCase(c) {
+		return c
+	}
+	for _, c := range e.Dir {
+		if !util.IsChoiceOrCase(c) {
+			continue
+		}
+		if found := schemaChild(c, name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// value returns the TypedValue of the value of the leaf or leaf-list
+// described by entry, or an error if value doesn't match its YANG type. The
+// value of a leaf-list is a JSON array, or a single element.
+func (m *modelSchema) value(entry *yang.Entry, value string) (*pb.TypedValue, error) {
+	if !entry.IsLeaf() && !entry.IsLeafList() {
+		return nil, fmt.Errorf("%s is not a leaf or leaf-list, its value must be a JSON file", entry.Name)
+	}
+	entry, err := util.ResolveIfLeafRef(entry)
+	if err != nil {
+		return nil, err
+	}
+	enums := m.enumNames(entry)
+	if !entry.IsLeafList() {
+		if s, err := strconv.Unquote(value); err == nil {
+			value = s
+		}
+		return leafValue(entry.Type, value, enums)
+	}
+	elems, err := leafListElements(value)
+	if err != nil {
+		return nil, err
+	}
+	list := &pb.ScalarArray{}
+	for _, elem := range elems {
+		v, err := leafValue(entry.Type, elem, enums)
+		if err != nil {
+			return nil, err
+		}
+		list.Element = append(list.Element, v)
+	}
+	return &pb.TypedValue{Value: &pb.TypedValue_LeaflistVal{LeaflistVal: list}}, nil
+}
+
+// leafListElements splits the value of a leaf-list, a JSON array of scalars or
+// a single element, into its elements.
+func leafListElements(value string) ([]string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		if s, err := strconv.Unquote(value); err == nil {
+			value = s
+		}
+		return []string{value}, nil
+	}
+	d := json.NewDecoder(bytes.NewReader([]byte(value)))
+	d.UseNumber()
+	var list []interface{}
+	if err := d.Decode(&list); err != nil {
+		return nil, fmt.Errorf("invalid leaf-list %s: %v", value, err)
+	}
+	var elems []string
+	for _, e := range list {
+		switch e := e.(type) {
+		case string:
+			elems = append(elems, e)
+		case json.Number, bool:
+			elems = append(elems, fmt.Sprint(e))
+		default:
+			return nil, fmt.Errorf("leaf-list element %v is not a scalar", e)
+		}
+	}
+	return elems, nil
+}
+
+// leafValue returns the TypedValue of s for the YANG type t. Enumeration and
+// identityref values must be one of enums, if known.
+func leafValue(t *yang.YangType, s string, enums map[string]bool) (*pb.TypedValue, error) {
+	switch t.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yint64:
+		i, err := strconv.ParseInt(s, 10, intBits(t.Kind))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", s, t.Kind)
+		}
+		if !t.Range.Contains(yang.YangRange{{Min: yang.FromInt(i), Max: yang.FromInt(i)}}) {
+			return nil, fmt.Errorf("%d is out of range %s", i, t.Range)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: i}}, nil
+	case yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Yuint64:
+		u, err := strconv.ParseUint(s, 10, intBits(t.Kind))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", s, t.Kind)
+		}
+		if !t.Range.Contains(yang.YangRange{{Min: yang.FromUint(u), Max: yang.FromUint(u)}}) {
+			return nil, fmt.Errorf("%d is out of range %s", u, t.Range)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: u}}, nil
+	case yang.Ydecimal64:
+		n, err := yang.ParseDecimal(s, uint8(t.FractionDigits))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid decimal64 with %d fraction digits", s, t.FractionDigits)
+		}
+		if !t.Range.Contains(yang.YangRange{{Min: n, Max: n}}) {
+			return nil, fmt.Errorf("%s is out of range %s", s, t.Range)
+		}
+		d, err := parseDecimal(s)
+		if err != nil {
+			return nil, err
+		}
+		for ; d.Precision < uint32(t.FractionDigits); d.Precision++ {
+			d.Digits *= 10
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: d}}, nil
+	case yang.Ybool:
+		if s != "true" && s != "false" {
+			return nil, fmt.Errorf("%q is not a valid boolean", s)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: s == "true"}}, nil
+	case yang.Yempty:
+		if s != "" && s != "true" {
+			return nil, fmt.Errorf("%q is not a valid empty value, use true", s)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: true}}, nil
+	case yang.Yenum, yang.Yidentityref:
+		if enums != nil && !enums[util.StripModulePrefix(s)] {
+			var names []string
+			for name := range enums {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("%q is not one of %s", s, strings.Join(names, ", "))
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: s}}, nil
+	case yang.Ybinary:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not valid base64", s)
+		}
+		if !t.Length.Contains(yang.YangRange{{Min: yang.FromInt(int64(len(b))), Max: yang.FromInt(int64(len(b)))}}) {
+			return nil, fmt.Errorf("length %d is out of range %s", len(b), t.Length)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_BytesVal{BytesVal: b}}, nil
+	case yang.Yunion:
+		var errs []string
+		for _, member := range t.Type {
+			v, err := leafValue(member, s, enums)
+			if err == nil {
+				return v, nil
+			}
+			errs = append(errs, err.Error())
+		}
+		return nil, fmt.Errorf("%q matches none of the union types: %s", s, strings.Join(errs, "; "))
+	case yang.Ystring:
+		n := int64(len([]rune(s)))
+		if !t.Length.Contains(yang.YangRange{{Min: yang.FromInt(n), Max: yang.FromInt(n)}}) {
+			return nil, fmt.Errorf("length %d of %q is out of range %s", n, s, t.Length)
+		}
+		patterns, posix := util.SanitizedPattern(t)
+		for _, p := range patterns {
+			compile := regexp.Compile
+			if posix {
+				compile = regexp.CompilePOSIX
+			}
+			re, err := compile(p)
+			if err != nil {
+				continue
+			}
+			if !re.MatchString(s) {
+				return nil, fmt.Errorf("%q does not match pattern %s", s, p)
+			}
+		}
+	}
+	return &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: s}}, nil
+}
+
+// intBits returns the size in bits of an integer YANG type.
+func intBits(k yang.TypeKind) int {
+	switch k {
+	case yang.Yint8, yang.Yuint8:
+		return 8
+	case yang.Yint16, yang.Yuint16:
+		return 16
+	case yang.Yint32, yang.Yuint32:
+		return 32
+	}
+	return 64
+}
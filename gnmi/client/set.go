@@ -0,0 +1,230 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// setCommand sets paths of targets.
+type setCommand struct {
+	deleteOpt   arrayFlags
+	replaceOpt  arrayFlags
+	updateOpt   arrayFlags
+	prefix      string
+	requestFile string
+	dryRun      bool
+	schema      bool
+}
+
+// NewSet returns the set command.
+func NewSet() Command {
+	return &setCommand{}
+}
+
+func (c *setCommand) Name() string {
+	return "set"
+}
+
+func (c *setCommand) Synopsis() string {
+	return "Deletes, replaces and updates paths of targets"
+}
+
+func (c *setCommand) SetFlags(fs *flag.FlagSet) {
+	fs.Var(&c.deleteOpt, "delete", "xpath to be deleted.")
+	fs.Var(&c.replaceOpt, "replace", "xpath:value pair to be replaced. Value can be numeric, boolean, string, or IETF JSON file (. starts with '@').")
+	fs.Var(&c.updateOpt, "update", "xpath:value pair to be updated. Value can be numeric, boolean, string, or IETF JSON file (. starts with '@').")
+	fs.StringVar(&c.prefix, "prefix", "", "prefix for the path. this is optional. valid values: oc, srl.")
+	fs.StringVar(&c.requestFile, "request_file", "", "YAML or JSON file describing the SetRequest, instead of -delete, -replace and -update.")
+	fs.BoolVar(&c.dryRun, "dry_run", false, "Print the SetRequest without sending it.")
+	fs.BoolVar(&c.schema, "schema", true, "Encode values according to the YANG type of the leaf in the compiled schema, guessing the type of values of paths outside of it. If false, always guess.")
+}
+
+// splitPathValue splits a xpath:value pair at the first ':' outside of list
+// keys, which may contain ':'.
+func splitPathValue(item string) []string {
+	inKey := false
+	for i := 0; i < len(item); i++ {
+		switch item[i] {
+		case '\\':
+			i++
+		case '[':
+			inKey = true
+		case ']':
+			inKey = false
+		case ':':
+			if !inKey {
+				return []string{item[:i], item[i+1:]}
+			}
+		}
+	}
+	return []string{item}
+}
+
+// buildPbUpdateList builds the updates of xpath:value pairs. Values of leaves
+// and leaf-lists of schema are encoded according to their YANG type, other
+// values are typed by guessing. A nil schema always guesses.
+func buildPbUpdateList(pathValuePairs []string, schema *modelSchema) ([]*pb.Update, error) {
+	var pbUpdateList []*pb.Update
+	for _, item := range pathValuePairs {
+		pathValuePair := splitPathValue(item)
+		if len(pathValuePair) != 2 || len(pathValuePair[1]) == 0 {
+			return nil, fmt.Errorf("invalid path-value pair: %v", item)
+		}
+		pbPath, err := xpath.ToGNMIPath(pathValuePair[0])
+		if err != nil {
+			return nil, fmt.Errorf("error in parsing xpath %q to gnmi path", pathValuePair[0])
+		}
+		var pbVal *pb.TypedValue
+		if pathValuePair[1][0] == '@' {
+			jsonFile := pathValuePair[1][1:]
+			jsonConfig, err := ioutil.ReadFile(jsonFile)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read data from file %v", jsonFile)
+			}
+			jsonConfig = bytes.Trim(jsonConfig, " \r\n\t")
+			pbVal = &pb.TypedValue{
+				Value: &pb.TypedValue_JsonIetfVal{
+					JsonIetfVal: jsonConfig,
+				},
+			}
+		} else if entry := schema.find(pbPath); entry != nil {
+			if pbVal, err = schema.value(entry, pathValuePair[1]); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %v", pathValuePair[0], err)
+			}
+		} else {
+			if schema != nil {
+				log.Warningf("%s is not in the schema, guessing the type of its value", pathValuePair[0])
+			}
+			pbVal = guessValue(pathValuePair[1])
+		}
+		pbUpdateList = append(pbUpdateList, &pb.Update{Path: pbPath, Val: pbVal})
+	}
+	return pbUpdateList, nil
+}
+
+// guessValue types a value without schema: quoted values are strings, then
+// integers, floats and booleans are tried.
+func guessValue(value string) *pb.TypedValue {
+	if strVal, err := strconv.Unquote(value); err == nil {
+		return &pb.TypedValue{
+			Value: &pb.TypedValue_StringVal{
+				StringVal: strVal,
+			},
+		}
+	}
+	if intVal, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return &pb.TypedValue{
+			Value: &pb.TypedValue_IntVal{
+				IntVal: intVal,
+			},
+		}
+	}
+	if floatVal, err := strconv.ParseFloat(value, 32); err == nil {
+		return &pb.TypedValue{
+			Value: &pb.TypedValue_FloatVal{
+				FloatVal: float32(floatVal),
+			},
+		}
+	}
+	if boolVal, err := strconv.ParseBool(value); err == nil {
+		return &pb.TypedValue{
+			Value: &pb.TypedValue_BoolVal{
+				BoolVal: boolVal,
+			},
+		}
+	}
+	return &pb.TypedValue{
+		Value: &pb.TypedValue_StringVal{
+			StringVal: value,
+		},
+	}
+}
+
+// setRequest builds the SetRequest from the request file or the flags.
+func (c *setCommand) setRequest() (*pb.SetRequest, error) {
+	if c.requestFile != "" {
+		if len(c.deleteOpt) > 0 || len(c.replaceOpt) > 0 || len(c.updateOpt) > 0 || c.prefix != "" {
+			return nil, fmt.Errorf("-request_file can't be used with -delete, -replace, -update or -prefix")
+		}
+		return loadSetRequest(c.requestFile)
+	}
+	var deleteList []*pb.Path
+	for _, xPath := range c.deleteOpt {
+		pbPath, err := xpath.ToGNMIPath(xPath)
+		if err != nil {
+			return nil, fmt.Errorf("error in parsing xpath %q to gnmi path", xPath)
+		}
+		deleteList = append(deleteList, pbPath)
+	}
+	var schema *modelSchema
+	if c.schema {
+		schema = deviceSchema()
+	}
+	replaceList, err := buildPbUpdateList(c.replaceOpt, schema)
+	if err != nil {
+		return nil, err
+	}
+	updateList, err := buildPbUpdateList(c.updateOpt, schema)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetRequest{
+		Delete:  deleteList,
+		Replace: replaceList,
+		Update:  updateList,
+		Prefix:  &pb.Path{Origin: c.prefix},
+	}, nil
+}
+
+// offline returns true with -dry_run, which only prints the SetRequest.
+func (c *setCommand) offline() bool {
+	return c.dryRun
+}
+
+func (c *setCommand) Run(ctx context.Context, clients []*Client) error {
+	setRequest, err := c.setRequest()
+	if err != nil {
+		return err
+	}
+	fmt.Println("== SetRequest:\n", proto.MarshalTextString(setRequest))
+	if c.dryRun {
+		return nil
+	}
+	if err := checkTargets(ctx, clients, &capabilityRequirements{encodings: setRequestEncodings(setRequest)}); err != nil {
+		return err
+	}
+	return forEach(clients, true, func(client *Client) error {
+		ctx, cancel := requestContext(ctx)
+		defer cancel()
+		setResponse, err := client.Set(ctx, setRequest)
+		if err != nil {
+			return fmt.Errorf("Set failed: %v", err)
+		}
+		fmt.Println("== SetResponse:\n", proto.MarshalTextString(setResponse))
+		return nil
+	})
+}
@@ -0,0 +1,132 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kylelemons/godebug/pretty"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestSplitPathValue(t *testing.T) {
+	tests := []struct {
+		item string
+		want []string
+	}{
+		{"/a/b:1", []string{"/a/b", "1"}},
+		{"/a/b:00:16:3e", []string{"/a/b", "00:16:3e"}},
+		{"/a/b[ip=2001:db8::1]/c:x", []string{"/a/b[ip=2001:db8::1]/c", "x"}},
+		{`/a/b[k=v\]:]/c:x`, []string{`/a/b[k=v\]:]/c`, "x"}},
+		{"/a/b", []string{"/a/b"}},
+	}
+	for _, test := range tests {
+		if diff := pretty.Compare(test.want, splitPathValue(test.item)); diff != "" {
+			t.Errorf("splitPathValue(%q): (-want +got)\n%s", test.item, diff)
+		}
+	}
+}
+
+func TestBuildPbUpdateList(t *testing.T) {
+	str := func(s string) *pb.TypedValue { return &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: s}} }
+	uint := func(u uint64) *pb.TypedValue { return &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: u}} }
+	leaflist := func(vals ...*pb.TypedValue) *pb.TypedValue {
+		return &pb.TypedValue{Value: &pb.TypedValue_LeaflistVal{LeaflistVal: &pb.ScalarArray{Element: vals}}}
+	}
+	const intf = "/interfaces/interface[name=eth0]"
+	tests := []struct {
+		name    string
+		item    string
+		guess   bool
+		want    *pb.TypedValue
+		wantErr string
+	}{
+		{name: "Uint", item: intf + "/config/mtu:1500", want: uint(1500)},
+		{name: "Quoted uint", item: intf + `/config/mtu:"1500"`, want: uint(1500)},
+		{name: "Uint out of range", item: intf + "/config/mtu:70000", wantErr: "not a valid uint16"},
+		{name: "Negative uint", item: intf + "/config/mtu:-1", wantErr: "not a valid uint16"},
+		{name: "Bool", item: intf + "/config/enabled:false", want: &pb.TypedValue{Value: &pb.TypedValue_BoolVal{}}},
+		{name: "Invalid bool", item: intf + "/config/enabled:yes", wantErr: "not a valid boolean"},
+		{
+			name: "Decimal",
+			item: "/components/component[name=cpu]/state/temperature/instant:45",
+			want: &pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: &pb.Decimal64{Digits: 450, Precision: 1}}},
+		},
+		{name: "Decimal too precise", item: "/components/component[name=cpu]/state/temperature/instant:45.25", wantErr: "fraction digits"},
+		{name: "Enumeration", item: "/messages/config/severity:WARNING", want: str("WARNING")},
+		{name: "Invalid enumeration", item: "/messages/config/severity:LOUD", wantErr: `"LOUD" is not one of`},
+		{
+			name: "Identityref with prefix",
+			item: "/system/aaa/server-groups/server-group[name=g]/config/type:openconfig-aaa:TACACS",
+			want: str("openconfig-aaa:TACACS"),
+		},
+		{name: "Invalid identityref", item: "/system/aaa/server-groups/server-group[name=g]/config/type:LDAP", wantErr: "not one of"},
+		{name: "Leafref", item: "/system/aaa/server-groups/server-group[name=g]/name:g", want: str("g")},
+		{
+			name: "Leaf-list",
+			item: `/system/dns/config/search:["example.com", "example.net"]`,
+			want: leaflist(str("example.com"), str("example.net")),
+		},
+		{name: "Leaf-list single element", item: "/system/dns/config/search:example.com", want: leaflist(str("example.com"))},
+		{
+			name: "Leaf-list of union",
+			item: `/system/aaa/authentication/config/authentication-method:["TACACS_ALL", "ldap"]`,
+			want: leaflist(str("TACACS_ALL"), str("ldap")),
+		},
+		{
+			name:    "Pattern",
+			item:    `/system/dns/host-entries/host-entry[hostname=h]/config/ipv4-address:["10.0.0.1", "10.0.0"]`,
+			wantErr: "does not match pattern",
+		},
+		{name: "Not a leaf", item: "/system/config:1", wantErr: "not a leaf"},
+		{name: "Not in schema", item: "/vendor/counter:1", want: &pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: 1}}},
+		{name: "Guess", item: intf + "/config/mtu:1500", guess: true, want: &pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: 1500}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema := deviceSchema()
+			if test.guess {
+				schema = nil
+			}
+			got, err := buildPbUpdateList([]string{test.item}, schema)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("buildPbUpdateList(%q) returned error %v, want error containing %q", test.item, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildPbUpdateList(%q) failed: %v", test.item, err)
+			}
+			if !proto.Equal(got[0].Val, test.want) {
+				t.Errorf("buildPbUpdateList(%q) = %v, want %v", test.item, got[0].Val, test.want)
+			}
+		})
+	}
+}
+
+func TestDryRunWithoutTargets(t *testing.T) {
+	// No connection flags are set, so connecting would fail.
+	c := &setCommand{
+		requestFile: writeTempFile(t, "request.yaml", "operations:\n  - delete: /system/config/hostname\n"),
+		dryRun:      true,
+	}
+	if err := run(c); err != nil {
+		t.Errorf("run with -dry_run failed: %v", err)
+	}
+}
@@ -0,0 +1,376 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/gnxi/utils/xpath"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"gopkg.in/yaml.v2"
+)
+
+// setFile is a SetRequest described in a YAML or JSON file.
+type setFile struct {
+	Prefix *setPath `yaml:"prefix"`
+	// Operations are the deletes, replaces and updates, listed in the order
+	// the target applies them: deletes, then replaces, then updates.
+	Operations []setOperation `yaml:"operations"`
+	Extensions []setExtension `yaml:"extensions"`
+}
+
+// setPath is a path, either an xpath or an object with its origin and target.
+type setPath struct {
+	Path   string `yaml:"path"`
+	Origin string `yaml:"origin"`
+	Target string `yaml:"target"`
+}
+
+func (p *setPath) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&p.Path); err == nil {
+		return nil
+	}
+	type plain setPath
+	return unmarshal((*plain)(p))
+}
+
+func (p *setPath) gnmiPath() (*pb.Path, error) {
+	path, err := xpath.ToGNMIPath(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error in parsing xpath %q to gnmi path: %v", p.Path, err)
+	}
+	path.Origin, path.Target = p.Origin, p.Target
+	return path, nil
+}
+
+// setOperation is one of a delete, replace or update.
+type setOperation struct {
+	Delete  *setPath   `yaml:"delete"`
+	Replace *setUpdate `yaml:"replace"`
+	Update  *setUpdate `yaml:"update"`
+}
+
+// setUpdate is a path and its typed value, an object with a single field
+// named after the type of the value.
+type setUpdate struct {
+	Path   string                 `yaml:"path"`
+	Origin string                 `yaml:"origin"`
+	Target string                 `yaml:"target"`
+	Value  map[string]interface{} `yaml:"value"`
+}
+
+// setExtension is a registered or master arbitration extension.
+type setExtension struct {
+	Registered *struct {
+		ID int32 `yaml:"id"`
+		// Msg is the base64 encoded payload.
+		Msg string `yaml:"msg"`
+	} `yaml:"registered"`
+	MasterArbitration *struct {
+		Role           string `yaml:"role"`
+		ElectionID     uint64 `yaml:"election_id"`
+		ElectionIDHigh uint64 `yaml:"election_id_high"`
+	} `yaml:"master_arbitration"`
+}
+
+// loadSetRequest loads a SetRequest from a YAML or JSON file.
+func loadSetRequest(file string) (*pb.SetRequest, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	f := &setFile{}
+	if err = yaml.UnmarshalStrict(b, f); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+	}
+	req, err := f.setRequest(filepath.Dir(file))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SetRequest in %s: %v", file, err)
+	}
+	return req, nil
+}
+
+// setRequest builds the SetRequest, reading files of values relative to dir.
+func (f *setFile) setRequest(dir string) (*pb.SetRequest, error) {
+	req := &pb.SetRequest{}
+	if f.Prefix != nil {
+		prefix, err := f.Prefix.gnmiPath()
+		if err != nil {
+			return nil, fmt.Errorf("prefix: %v", err)
+		}
+		req.Prefix = prefix
+	}
+	kinds := []string{"delete", "replace", "update"}
+	last := 0
+	for i, op := range f.Operations {
+		kind, set := 0, 0
+		if op.Delete != nil {
+			kind, set = 0, set+1
+		}
+		if op.Replace != nil {
+			kind, set = 1, set+1
+		}
+		if op.Update != nil {
+			kind, set = 2, set+1
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("operation %d: want one of delete, replace or update", i+1)
+		}
+		if kind < last {
+			return nil, fmt.Errorf("operation %d: %s after %s, the target applies deletes, then replaces, then updates", i+1, kinds[kind], kinds[last])
+		}
+		last = kind
+		switch {
+		case op.Delete != nil:
+			path, err := op.Delete.gnmiPath()
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %v", i+1, err)
+			}
+			req.Delete = append(req.Delete, path)
+		case op.Replace != nil:
+			u, err := op.Replace.update(dir)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %v", i+1, err)
+			}
+			req.Replace = append(req.Replace, u)
+		case op.Update != nil:
+			u, err := op.Update.update(dir)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %v", i+1, err)
+			}
+			req.Update = append(req.Update, u)
+		}
+	}
+	for i, e := range f.Extensions {
+		ext, err := e.extension()
+		if err != nil {
+			return nil, fmt.Errorf("extension %d: %v", i+1, err)
+		}
+		req.Extension = append(req.Extension, ext)
+	}
+	return req, nil
+}
+
+func (u *setUpdate) update(dir string) (*pb.Update, error) {
+	path, err := (&setPath{Path: u.Path, Origin: u.Origin, Target: u.Target}).gnmiPath()
+	if err != nil {
+		return nil, err
+	}
+	val, err := typedValue(u.Value, dir)
+	if err != nil {
+		return nil, fmt.Errorf("value of %s: %v", u.Path, err)
+	}
+	return &pb.Update{Path: path, Val: val}, nil
+}
+
+func (e *setExtension) extension() (*gnmi_ext.Extension, error) {
+	switch {
+	case e.Registered != nil && e.MasterArbitration == nil:
+		msg, err := base64.StdEncoding.DecodeString(e.Registered.Msg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 msg: %v", err)
+		}
+		return &gnmi_ext.Extension{Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+			Id:  gnmi_ext.ExtensionID(e.Registered.ID),
+			Msg: msg,
+		}}}, nil
+	case e.MasterArbitration != nil && e.Registered == nil:
+		ma := e.MasterArbitration
+		arbitration := &gnmi_ext.MasterArbitration{ElectionId: &gnmi_ext.Uint128{High: ma.ElectionIDHigh, Low: ma.ElectionID}}
+		if ma.Role != "" {
+			arbitration.Role = &gnmi_ext.Role{Id: ma.Role}
+		}
+		return &gnmi_ext.Extension{Ext: &gnmi_ext.Extension_MasterArbitration{MasterArbitration: arbitration}}, nil
+	}
+	return nil, fmt.Errorf("want one of registered or master_arbitration")
+}
+
+// typedValue returns the TypedValue of a value object, whose single field is
+// named after the type of the value. Files of json_ietf_file and json_file
+// values are relative to dir.
+func typedValue(spec map[string]interface{}, dir string) (*pb.TypedValue, error) {
+	if len(spec) != 1 {
+		return nil, fmt.Errorf("want a single type, got %d", len(spec))
+	}
+	for kind, v := range spec {
+		return scalarValue(kind, v, dir)
+	}
+	return nil, nil
+}
+
+func scalarValue(kind string, v interface{}, dir string) (*pb.TypedValue, error) {
+	switch kind {
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("string value %v is not a string", v)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: s}}, nil
+	case "ascii":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("ascii value %v is not a string", v)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_AsciiVal{AsciiVal: s}}, nil
+	case "int":
+		i, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int value: %v", err)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: i}}, nil
+	case "uint":
+		u, err := strconv.ParseUint(fmt.Sprint(v), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uint value: %v", err)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: u}}, nil
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("bool value %v is not a boolean", v)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: b}}, nil
+	case "float", "double":
+		f, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", kind, err)
+		}
+		if kind == "float" {
+			if math.Abs(f) > math.MaxFloat32 {
+				return nil, fmt.Errorf("float value %v out of range", v)
+			}
+			return &pb.TypedValue{Value: &pb.TypedValue_FloatVal{FloatVal: float32(f)}}, nil
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_DoubleVal{DoubleVal: f}}, nil
+	case "decimal":
+		s := fmt.Sprint(v)
+		if f, ok := v.(float64); ok {
+			s = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		d, err := parseDecimal(s)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: d}}, nil
+	case "bytes":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("bytes value %v is not a base64 string", v)
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 bytes value: %v", err)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_BytesVal{BytesVal: b}}, nil
+	case "leaflist":
+		elements, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("leaflist value %v is not a list", v)
+		}
+		leaflist := &pb.ScalarArray{}
+		for i, e := range elements {
+			spec, ok := jsonCompatible(e).(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("leaflist element %d is not a typed value", i+1)
+			}
+			val, err := typedValue(spec, dir)
+			if err != nil {
+				return nil, fmt.Errorf("leaflist element %d: %v", i+1, err)
+			}
+			leaflist.Element = append(leaflist.Element, val)
+		}
+		return &pb.TypedValue{Value: &pb.TypedValue_LeaflistVal{LeaflistVal: leaflist}}, nil
+	case "json", "json_ietf":
+		b, err := json.Marshal(jsonCompatible(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", kind, err)
+		}
+		return jsonValueOf(kind, b), nil
+	case "json_file", "json_ietf_file":
+		file, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s value %v is not a file name", kind, v)
+		}
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if !json.Valid(b) {
+			return nil, fmt.Errorf("invalid JSON in %s", file)
+		}
+		return jsonValueOf(strings.TrimSuffix(kind, "_file"), b), nil
+	}
+	return nil, fmt.Errorf("unknown value type %q", kind)
+}
+
+func jsonValueOf(kind string, b []byte) *pb.TypedValue {
+	if kind == "json" {
+		return &pb.TypedValue{Value: &pb.TypedValue_JsonVal{JsonVal: b}}
+	}
+	return &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: b}}
+}
+
+// parseDecimal parses a decimal number into a Decimal64.
+func parseDecimal(s string) (*pb.Decimal64, error) {
+	d := &pb.Decimal64{}
+	digits := s
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		d.Precision = uint32(len(s) - i - 1)
+		digits = s[:i] + s[i+1:]
+	}
+	var err error
+	if d.Digits, err = strconv.ParseInt(digits, 10, 64); err != nil || d.Precision > 18 {
+		return nil, fmt.Errorf("invalid decimal value %q", s)
+	}
+	return d, nil
+}
+
+// jsonCompatible converts the maps decoded from YAML, keyed by interface{},
+// into maps keyed by string.
+func jsonCompatible(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = jsonCompatible(e)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = jsonCompatible(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = jsonCompatible(e)
+		}
+		return l
+	}
+	return v
+}
@@ -0,0 +1,191 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+)
+
+// writeRequest writes files, named by file name, and returns the path of
+// request.yaml.
+func writeRequest(t *testing.T, files map[string]string) string {
+	t.Helper()
+	for name, content := range files {
+		writeTempFile(t, name, content)
+	}
+	return writeTempFile(t, "request.yaml", files["request.yaml"])
+}
+
+func TestLoadSetRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		want    *pb.SetRequest
+		wantErr string
+	}{
+		{
+			name: "All operations",
+			files: map[string]string{
+				"clock.json": `{"config": {"timezone-name": "UTC"}}`,
+				"request.yaml": `
+prefix: {path: /system, origin: openconfig, target: dev1}
+operations:
+  - delete: openflow/agent/config/max-backoff
+  - delete: {path: "ntp/servers/server[address=2001:db8::1]", origin: oc}
+  - replace:
+      path: clock
+      value: {json_ietf_file: clock.json}
+  - update:
+      path: config/hostname
+      value: {string: "1"}
+  - update:
+      path: openflow/agent/config/max-backoff
+      value: {uint: 12}
+  - update:
+      path: config/x
+      value: {decimal: "12.30"}
+  - update:
+      path: config/y
+      value: {leaflist: [{string: a}, {int: -1}]}
+  - update:
+      path: config/z
+      value: {json_ietf: {enabled: true, mtu: 1500}}
+extensions:
+  - master_arbitration: {role: admin, election_id: 7}
+  - registered: {id: 999, msg: AQI=}
+`,
+			},
+			want: &pb.SetRequest{
+				Prefix: &pb.Path{Origin: "openconfig", Target: "dev1", Elem: []*pb.PathElem{{Name: "system"}}},
+				Delete: []*pb.Path{
+					{Elem: []*pb.PathElem{{Name: "openflow"}, {Name: "agent"}, {Name: "config"}, {Name: "max-backoff"}}},
+					{Origin: "oc", Elem: []*pb.PathElem{{Name: "ntp"}, {Name: "servers"}, {Name: "server", Key: map[string]string{"address": "2001:db8::1"}}}},
+				},
+				Replace: []*pb.Update{{
+					Path: &pb.Path{Elem: []*pb.PathElem{{Name: "clock"}}},
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"config": {"timezone-name": "UTC"}}`)}},
+				}},
+				Update: []*pb.Update{
+					{
+						Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}, {Name: "hostname"}}},
+						Val:  &pb.TypedValue{Value: &pb.TypedValue_StringVal{StringVal: "1"}},
+					},
+					{
+						Path: &pb.Path{Elem: []*pb.PathElem{{Name: "openflow"}, {Name: "agent"}, {Name: "config"}, {Name: "max-backoff"}}},
+						Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 12}},
+					},
+					{
+						Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}, {Name: "x"}}},
+						Val:  &pb.TypedValue{Value: &pb.TypedValue_DecimalVal{DecimalVal: &pb.Decimal64{Digits: 1230, Precision: 2}}},
+					},
+					{
+						Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}, {Name: "y"}}},
+						Val: &pb.TypedValue{Value: &pb.TypedValue_LeaflistVal{LeaflistVal: &pb.ScalarArray{Element: []*pb.TypedValue{
+							{Value: &pb.TypedValue_StringVal{StringVal: "a"}},
+							{Value: &pb.TypedValue_IntVal{IntVal: -1}},
+						}}}},
+					},
+					{
+						Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}, {Name: "z"}}},
+						Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"enabled":true,"mtu":1500}`)}},
+					},
+				},
+				Extension: []*gnmi_ext.Extension{
+					{Ext: &gnmi_ext.Extension_MasterArbitration{MasterArbitration: &gnmi_ext.MasterArbitration{
+						Role:       &gnmi_ext.Role{Id: "admin"},
+						ElectionId: &gnmi_ext.Uint128{Low: 7},
+					}}},
+					{Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{Id: 999, Msg: []byte{1, 2}}}},
+				},
+			},
+		},
+		{
+			name:  "JSON file",
+			files: map[string]string{"request.yaml": `{"operations": [{"update": {"path": "/a", "value": {"bool": true}}}]}`},
+			want: &pb.SetRequest{Update: []*pb.Update{{
+				Path: &pb.Path{Elem: []*pb.PathElem{{Name: "a"}}},
+				Val:  &pb.TypedValue{Value: &pb.TypedValue_BoolVal{BoolVal: true}},
+			}}},
+		},
+		{
+			name:    "Out of order",
+			files:   map[string]string{"request.yaml": "operations:\n  - update: {path: /a, value: {int: 1}}\n  - delete: /a\n"},
+			wantErr: "delete after update",
+		},
+		{
+			name:    "Two operations in one",
+			files:   map[string]string{"request.yaml": "operations:\n  - {delete: /a, update: {path: /a, value: {int: 1}}}\n"},
+			wantErr: "want one of delete, replace or update",
+		},
+		{
+			name:    "Two value types",
+			files:   map[string]string{"request.yaml": "operations:\n  - update: {path: /a, value: {int: 1, uint: 1}}\n"},
+			wantErr: "want a single type",
+		},
+		{
+			name:    "Mistyped value",
+			files:   map[string]string{"request.yaml": "operations:\n  - update: {path: /a, value: {uint: -1}}\n"},
+			wantErr: "invalid uint value",
+		},
+		{
+			name:    "Unknown field",
+			files:   map[string]string{"request.yaml": "operation:\n  - delete: /a\n"},
+			wantErr: "failed to parse",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := loadSetRequest(writeRequest(t, test.files))
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("loadSetRequest: got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadSetRequest failed: %v", err)
+			}
+			if !proto.Equal(got, test.want) {
+				t.Errorf("loadSetRequest: got\n%s\nwant\n%s", proto.MarshalTextString(got), proto.MarshalTextString(test.want))
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    *pb.Decimal64
+		wantErr bool
+	}{
+		{"12.30", &pb.Decimal64{Digits: 1230, Precision: 2}, false},
+		{"-0.5", &pb.Decimal64{Digits: -5, Precision: 1}, false},
+		{"7", &pb.Decimal64{Digits: 7}, false},
+		{"1.2.3", nil, true},
+		{"abc", nil, true},
+	}
+	for _, test := range tests {
+		got, err := parseDecimal(test.in)
+		if (err != nil) != test.wantErr || (err == nil && !proto.Equal(got, test.want)) {
+			t.Errorf("parseDecimal(%q): got (%v, %v), want %v", test.in, got, err, test.want)
+		}
+	}
+}
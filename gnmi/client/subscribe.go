@@ -0,0 +1,332 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// subscribeCommand subscribes to paths of a target.
+type subscribeCommand struct {
+	xPathFlags        arrayFlags
+	pbPathFlags       arrayFlags
+	pbModelDataFlags  arrayFlags
+	subscriptionOnce  bool
+	subscriptionPoll  bool
+	streamOnChange    bool
+	sampleInterval    uint64
+	encodingFormat    string
+	suppressRedundant bool
+	heartbeatInterval uint64
+	updatesOnly       bool
+	format            string
+	collect           bool
+	reconnectBackoff  time.Duration
+	maxBackoff        time.Duration
+	cacheAddress      string
+	prometheusAddress string
+	metricsFile       string
+	subscriptionFile  string
+}
+
+// NewSubscribe returns the subscribe command.
+func NewSubscribe() Command {
+	return &subscribeCommand{}
+}
+
+func (c *subscribeCommand) Name() string {
+	return "subscribe"
+}
+
+func (c *subscribeCommand) Synopsis() string {
+	return "Subscribes to paths of a target in STREAM, POLL or ONCE mode"
+}
+
+func (c *subscribeCommand) SetFlags(fs *flag.FlagSet) {
+	fs.Var(&c.xPathFlags, "xpath", "xpath of the config node to be fetched")
+	fs.Var(&c.pbPathFlags, "pbpath", "protobuf format path of the config node to be fetched")
+	fs.Var(&c.pbModelDataFlags, "model_data", "Data models to be used by the target in the format of 'name,organization,version'")
+	fs.StringVar(&c.subscriptionFile, "subscription_file", "", "YAML or JSON file describing the prefix and the subscriptions, each with its path, mode, sample interval, suppress_redundant and heartbeat interval, instead of -xpath, -pbpath and the subscription mode flags")
+	fs.BoolVar(&c.subscriptionOnce, "once", false, "If true, the target sends values once off")
+	fs.BoolVar(&c.subscriptionPoll, "poll", false, "If true, the target sends values on request")
+	fs.BoolVar(&c.streamOnChange, "stream_on_change", false, "If true, the target sends updates on change")
+	fs.Uint64Var(&c.sampleInterval, "sample_interval", 0, "If defined, the target sends sample values according to this interval in nano seconds")
+	fs.StringVar(&c.encodingFormat, "encoding", "JSON_IETF", "The encoding format used by the target for notifications")
+	fs.BoolVar(&c.suppressRedundant, "suppress_redundant", false, "If true, in SAMPLE mode, unchanged values are not sent by the target")
+	fs.Uint64Var(&c.heartbeatInterval, "heartbeat_interval", 0, "Specifies maximum allowed period of silence in seconds when surpress redundant is used")
+	fs.BoolVar(&c.updatesOnly, "updates_only", false, "If true, the target only transmits updates to the subscribed paths")
+	fs.StringVar(&c.format, "format", formatProto, formatFlagUsage)
+	fs.BoolVar(&c.collect, "collect", false, "If true, subscribe to all targets concurrently in STREAM mode until interrupted, resubscribing when a subscription fails, and tag the output with the target names")
+	fs.DurationVar(&c.reconnectBackoff, "reconnect_backoff", time.Second, "With -collect, the delay before resubscribing to a target, doubled on every consecutive failure")
+	fs.DurationVar(&c.maxBackoff, "max_reconnect_backoff", time.Minute, "With -collect, the maximum delay before resubscribing to a target")
+	fs.StringVar(&c.cacheAddress, "cache_address", "", "If set, keep the latest values received in collector mode and serve gNMI Get requests from them on this address, like localhost:9340")
+	fs.StringVar(&c.prometheusAddress, "prometheus_address", "", "If set, export the numeric values received in collector mode as Prometheus metrics on this address at /metrics, like localhost:9273; requires -metrics_file")
+	fs.StringVar(&c.metricsFile, "metrics_file", "", "YAML or JSON file mapping paths to the names of the metrics exported with -prometheus_address")
+}
+
+func (c *subscribeCommand) Run(ctx context.Context, clients []*Client) error {
+	if c.cacheAddress != "" || c.prometheusAddress != "" {
+		c.collect = true
+	}
+	if (c.prometheusAddress == "") != (c.metricsFile == "") {
+		return errors.New("-prometheus_address and -metrics_file must be used together")
+	}
+	if len(clients) != 1 && !c.collect {
+		return fmt.Errorf("subscribe supports a single target without -collect, got %d", len(clients))
+	}
+	if err := checkFormat(c.format); err != nil {
+		return err
+	}
+	encoding, err := parseEncoding(c.encodingFormat)
+	if err != nil {
+		return fmt.Errorf("Error parsing encoding: %v", err)
+	}
+	subscriptionListMode, err := subscriptionMode(c.subscriptionPoll, c.subscriptionOnce)
+	if err != nil {
+		return err
+	}
+	pbModelDataList, err := parseModelData(c.pbModelDataFlags)
+	if err != nil {
+		return fmt.Errorf("Error parsing models: %v", err)
+	}
+	var prefix *pb.Path
+	var subscriptions []*pb.Subscription
+	if c.subscriptionFile != "" {
+		if len(c.xPathFlags) != 0 || len(c.pbPathFlags) != 0 || c.streamOnChange || c.sampleInterval != 0 || c.suppressRedundant || c.heartbeatInterval != 0 {
+			return errors.New("-subscription_file can't be used with -xpath, -pbpath, -stream_on_change, -sample_interval, -suppress_redundant or -heartbeat_interval")
+		}
+		if prefix, subscriptions, err = loadSubscriptions(c.subscriptionFile); err != nil {
+			return err
+		}
+	} else {
+		pbPathList, err := parsePaths(c.xPathFlags, c.pbPathFlags)
+		if err != nil {
+			return fmt.Errorf("Error parsing paths: %v", err)
+		}
+		if subscriptions, err = c.assembleSubscriptions(pbPathList); err != nil {
+			return fmt.Errorf("Error assembling subscriptions: %v", err)
+		}
+	}
+	list := &pb.SubscriptionList{
+		Prefix:       prefix,
+		Encoding:     encoding,
+		Mode:         subscriptionListMode,
+		Subscription: subscriptions,
+		UpdatesOnly:  c.updatesOnly,
+		UseModels:    pbModelDataList,
+	}
+	if err := checkTargets(ctx, clients, &capabilityRequirements{encodings: []pb.Encoding{encoding}, models: pbModelDataList}); err != nil {
+		return err
+	}
+
+	ctx, cancel := streamContext(ctx)
+	defer cancel()
+	if c.collect {
+		if subscriptionListMode != pb.SubscriptionList_STREAM {
+			return errors.New("-collect can't be used with -once or -poll")
+		}
+		col := &collector{
+			list: list,
+			// SAMPLE subscriptions resend all values every interval, others
+			// need the initial values again not to miss changes while down.
+			resumeUpdatesOnly: allSample(subscriptions),
+			backoff:           c.reconnectBackoff,
+			maxBackoff:        c.maxBackoff,
+			format:            c.format,
+			out:               os.Stdout,
+		}
+		if c.cacheAddress != "" {
+			cache := newCache()
+			col.sinks = append(col.sinks, cache)
+			stop, err := serveCache(cache, c.cacheAddress)
+			if err != nil {
+				return fmt.Errorf("Error serving the cache: %v", err)
+			}
+			defer stop()
+		}
+		if c.prometheusAddress != "" {
+			mappings, err := loadMetricMappings(c.metricsFile)
+			if err != nil {
+				return err
+			}
+			exporter := newExporter(mappings)
+			col.sinks = append(col.sinks, exporter)
+			stop, err := serveMetrics(exporter, c.prometheusAddress)
+			if err != nil {
+				return fmt.Errorf("Error serving metrics: %v", err)
+			}
+			defer stop()
+		}
+		return col.collect(ctx, clients)
+	}
+	subscribeClient, err := clients[0].Subscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("Error creating GNMI_SubscribeClient: %v", err)
+	}
+
+	request := &pb.SubscribeRequest{Request: &pb.SubscribeRequest_Subscribe{Subscribe: list}}
+	log.V(1).Info("SubscribeRequest:\n", proto.MarshalTextString(request))
+
+	if err := subscribeClient.Send(request); err != nil {
+		return fmt.Errorf("Failed to send request: %v", err)
+	}
+
+	out := newPrinter(os.Stdout, c.format, clients[0].Target.Name, false)
+	switch subscriptionListMode {
+	case pb.SubscriptionList_STREAM:
+		if err := stream(subscribeClient, out); err != nil {
+			return fmt.Errorf("Error using STREAM mode: %v", err)
+		}
+	case pb.SubscriptionList_POLL:
+		if err := poll(subscribeClient, out, c.updatesOnly, pollUser); err != nil {
+			return fmt.Errorf("Error using POLL mode: %v", err)
+		}
+	case pb.SubscriptionList_ONCE:
+		if err := once(subscribeClient, out); err != nil {
+			return fmt.Errorf("Error using ONCE mode: %v", err)
+		}
+	}
+	return nil
+}
+
+func pollUser() {
+	log.Info("Press enter to poll")
+	fmt.Scanln()
+}
+
+func stream(subscribeClient pb.GNMI_SubscribeClient, out *printer) error {
+	for {
+		if closed, err := receiveNotifications(subscribeClient, out); err != nil {
+			return err
+		} else if closed {
+			return nil
+		}
+	}
+}
+
+func poll(subscribeClient pb.GNMI_SubscribeClient, out *printer, updatesOnly bool, pollInput func()) error {
+	ready := make(chan bool, 1)
+	ready <- true
+	pollRequest := &pb.SubscribeRequest{Request: &pb.SubscribeRequest_Poll{Poll: &pb.Poll{}}}
+	if updatesOnly {
+		res, err := subscribeClient.Recv()
+		if err != nil {
+			return err
+		}
+		if syncRes := res.GetSyncResponse(); !syncRes {
+			return errors.New("-updates_only flag is set but failed to receive SyncResponse first for POLL mode")
+		}
+		if err := out.subscribeResponse(res); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ready:
+			pollInput()
+			if err := subscribeClient.Send(pollRequest); err != nil {
+				return err
+			}
+			log.V(1).Info("SubscribeRequest:\n", proto.MarshalTextString(pollRequest))
+		default:
+			if closed, err := receiveNotifications(subscribeClient, out); err != nil {
+				return err
+			} else if closed {
+				return nil
+			}
+			ready <- true
+		}
+	}
+
+}
+
+func once(subscribeClient pb.GNMI_SubscribeClient, out *printer) error {
+	if _, err := receiveNotifications(subscribeClient, out); err != nil {
+		return err
+	}
+	return nil
+}
+
+func receiveNotifications(subscribeClient pb.GNMI_SubscribeClient, out *printer) (bool, error) {
+	for {
+		res, err := subscribeClient.Recv()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		switch res.Response.(type) {
+		case *pb.SubscribeResponse_SyncResponse:
+			return false, out.subscribeResponse(res)
+		case *pb.SubscribeResponse_Update:
+			if err := out.subscribeResponse(res); err != nil {
+				return false, err
+			}
+		default:
+			return false, errors.New("unexpected response type")
+		}
+	}
+}
+
+func (c *subscribeCommand) assembleSubscriptions(paths []*pb.Path) ([]*pb.Subscription, error) {
+	var subscriptions []*pb.Subscription
+	var subscriptionMode pb.SubscriptionMode
+	switch {
+	case c.streamOnChange && c.sampleInterval != 0:
+		return nil, errors.New("only one of -stream_on_change and -sample_interval can be set")
+	case c.streamOnChange:
+		subscriptionMode = pb.SubscriptionMode_ON_CHANGE
+	case c.sampleInterval != 0:
+		subscriptionMode = pb.SubscriptionMode_SAMPLE
+	default:
+		subscriptionMode = pb.SubscriptionMode_TARGET_DEFINED
+	}
+	for _, path := range paths {
+		subscription := &pb.Subscription{
+			Path:              path,
+			Mode:              subscriptionMode,
+			SampleInterval:    c.sampleInterval,
+			SuppressRedundant: c.suppressRedundant,
+			HeartbeatInterval: c.heartbeatInterval,
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func subscriptionMode(subscriptionPoll, subscriptionOnce bool) (pb.SubscriptionList_Mode, error) {
+	switch {
+	case subscriptionPoll && subscriptionOnce:
+		return 0, errors.New("only one of -once and -poll can be set")
+	case subscriptionOnce:
+		return pb.SubscriptionList_ONCE, nil
+	case subscriptionPoll:
+		return pb.SubscriptionList_POLL, nil
+	default:
+		return pb.SubscriptionList_STREAM, nil
+	}
+}
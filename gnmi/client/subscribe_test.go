@@ -10,11 +10,12 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package main
+package client
 
 import (
 	"errors"
 	"io"
+	"io/ioutil"
 	"regexp"
 	"strings"
 	"testing"
@@ -23,6 +24,8 @@ import (
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
+var testPrinter = newPrinter(ioutil.Discard, formatProto, "", false)
+
 type MockClientStream struct {
 	gnmi.GNMI_SubscribeClient
 	responses   chan *gnmi.SubscribeResponse
@@ -78,7 +81,7 @@ func TestOnce(t *testing.T) {
 			for _, response := range test.responses {
 				stream.responses <- response
 			}
-			got := once(stream)
+			got := once(stream, testPrinter)
 			if diff := pretty.Compare(test.want, got); diff != "" {
 				t.Errorf("once(): (-want +got)\n%s", diff)
 			}
@@ -125,7 +128,7 @@ func TestStream(t *testing.T) {
 			for _, response := range test.responses {
 				clientStream.responses <- response
 			}
-			got := stream(clientStream)
+			got := stream(clientStream, testPrinter)
 			if diff := pretty.Compare(test.want, got); diff != "" {
 				t.Errorf("stream(): (-want +got)\n%s", diff)
 			}
@@ -211,7 +214,7 @@ func TestPoll(t *testing.T) {
 			for _, response := range test.responses {
 				clientStream.responses <- response
 			}
-			got := poll(clientStream, test.updatesOnly, testPollInput)
+			got := poll(clientStream, testPrinter, test.updatesOnly, testPollInput)
 			if diff := pretty.Compare(test.want, got); diff != "" {
 				t.Errorf("poll(): (-want +got)\n%s", diff)
 			}
@@ -285,7 +288,8 @@ func TestAssembleSubscriptions(t *testing.T) {
 	}
 	for i, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			subscriptionsGot, errGot := assembleSubscriptions(test.streamOnChange, test.sampleInterval, test.paths)
+			c := &subscribeCommand{streamOnChange: test.streamOnChange, sampleInterval: test.sampleInterval}
+			subscriptionsGot, errGot := c.assembleSubscriptions(test.paths)
 			got := struct {
 				err           error
 				subscriptions []*gnmi.Subscription
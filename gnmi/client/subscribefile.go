@@ -0,0 +1,145 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"gopkg.in/yaml.v2"
+)
+
+// subscriptionFile is the prefix and subscriptions of a subscription list
+// described in a YAML or JSON file.
+type subscriptionFile struct {
+	Prefix *setPath `yaml:"prefix"`
+	// Target is the target of the prefix, like the target of a Prefix object.
+	Target        string               `yaml:"target"`
+	Subscriptions []*subscriptionEntry `yaml:"subscriptions"`
+}
+
+// subscriptionEntry is a subscription to a path. Intervals are durations
+// like "10s", or nanoseconds.
+type subscriptionEntry struct {
+	Path   string `yaml:"path"`
+	Origin string `yaml:"origin"`
+	// Mode is target_defined, the default, on_change or sample.
+	Mode              string        `yaml:"mode"`
+	SampleInterval    time.Duration `yaml:"sample_interval"`
+	SuppressRedundant bool          `yaml:"suppress_redundant"`
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
+}
+
+// loadSubscriptions loads the prefix and subscriptions of a subscription list
+// from a YAML or JSON file.
+func loadSubscriptions(file string) (*pb.Path, []*pb.Subscription, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	f := &subscriptionFile{}
+	if err = yaml.UnmarshalStrict(b, f); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %v", file, err)
+	}
+	prefix, subscriptions, err := f.subscriptions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid subscriptions in %s: %v", file, err)
+	}
+	return prefix, subscriptions, nil
+}
+
+// subscriptions returns the prefix, nil if it is empty, and the
+// subscriptions of the file.
+func (f *subscriptionFile) subscriptions() (*pb.Path, []*pb.Subscription, error) {
+	var prefix *pb.Path
+	if f.Prefix != nil {
+		var err error
+		if prefix, err = f.Prefix.gnmiPath(); err != nil {
+			return nil, nil, fmt.Errorf("prefix: %v", err)
+		}
+	}
+	if f.Target != "" {
+		if prefix.GetTarget() != "" && prefix.GetTarget() != f.Target {
+			return nil, nil, fmt.Errorf("target %q differs from the target %q of the prefix", f.Target, prefix.GetTarget())
+		}
+		if prefix == nil {
+			prefix = &pb.Path{}
+		}
+		prefix.Target = f.Target
+	}
+	if len(f.Subscriptions) == 0 {
+		return nil, nil, errors.New("no subscriptions")
+	}
+	var subscriptions []*pb.Subscription
+	for i, s := range f.Subscriptions {
+		sub, err := s.subscription()
+		if err != nil {
+			return nil, nil, fmt.Errorf("subscription %d: %v", i+1, err)
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+	return prefix, subscriptions, nil
+}
+
+func (s *subscriptionEntry) subscription() (*pb.Subscription, error) {
+	if s == nil || s.Path == "" {
+		return nil, errors.New("missing path")
+	}
+	path, err := (&setPath{Path: s.Path, Origin: s.Origin}).gnmiPath()
+	if err != nil {
+		return nil, err
+	}
+	mode := pb.SubscriptionMode_TARGET_DEFINED
+	if s.Mode != "" {
+		m, ok := pb.SubscriptionMode_value[strings.ToUpper(s.Mode)]
+		if !ok {
+			return nil, fmt.Errorf("invalid mode %q of %s, want target_defined, on_change or sample", s.Mode, s.Path)
+		}
+		mode = pb.SubscriptionMode(m)
+	}
+	switch {
+	case s.SampleInterval < 0 || s.HeartbeatInterval < 0:
+		return nil, fmt.Errorf("negative interval of %s", s.Path)
+	case mode != pb.SubscriptionMode_SAMPLE && (s.SampleInterval != 0 || s.SuppressRedundant):
+		return nil, fmt.Errorf("sample_interval and suppress_redundant of %s require mode sample", s.Path)
+	case mode == pb.SubscriptionMode_TARGET_DEFINED && s.HeartbeatInterval != 0:
+		return nil, fmt.Errorf("heartbeat_interval of %s requires mode on_change or sample", s.Path)
+	}
+	return &pb.Subscription{
+		Path:              path,
+		Mode:              mode,
+		SampleInterval:    uint64(s.SampleInterval),
+		SuppressRedundant: s.SuppressRedundant,
+		HeartbeatInterval: uint64(s.HeartbeatInterval),
+	}, nil
+}
+
+// allSample returns true if there are subscriptions, all in SAMPLE mode.
+func allSample(subscriptions []*pb.Subscription) bool {
+	if len(subscriptions) == 0 {
+		return false
+	}
+	for _, s := range subscriptions {
+		if s.GetMode() != pb.SubscriptionMode_SAMPLE {
+			return false
+		}
+	}
+	return true
+}
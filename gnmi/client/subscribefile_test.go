@@ -0,0 +1,151 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestLoadSubscriptions(t *testing.T) {
+	tests := []struct {
+		name              string
+		content           string
+		wantPrefix        *pb.Path
+		wantSubscriptions []*pb.Subscription
+		wantErr           string
+	}{
+		{
+			name: "Mixed modes",
+			content: `
+prefix: /interfaces
+target: lab1
+subscriptions:
+- path: interface/state/oper-status
+  mode: on_change
+  heartbeat_interval: 1m
+- path: interface/state/counters
+  mode: SAMPLE
+  sample_interval: 10s
+  suppress_redundant: true
+  heartbeat_interval: 60000000000
+- path: /system
+  origin: openconfig
+`,
+			wantPrefix: &pb.Path{Target: "lab1", Elem: []*pb.PathElem{{Name: "interfaces"}}},
+			wantSubscriptions: []*pb.Subscription{
+				{
+					Path:              &pb.Path{Elem: []*pb.PathElem{{Name: "interface"}, {Name: "state"}, {Name: "oper-status"}}},
+					Mode:              pb.SubscriptionMode_ON_CHANGE,
+					HeartbeatInterval: 60000000000,
+				},
+				{
+					Path:              &pb.Path{Elem: []*pb.PathElem{{Name: "interface"}, {Name: "state"}, {Name: "counters"}}},
+					Mode:              pb.SubscriptionMode_SAMPLE,
+					SampleInterval:    10000000000,
+					SuppressRedundant: true,
+					HeartbeatInterval: 60000000000,
+				},
+				{
+					Path: &pb.Path{Origin: "openconfig", Elem: []*pb.PathElem{{Name: "system"}}},
+					Mode: pb.SubscriptionMode_TARGET_DEFINED,
+				},
+			},
+		},
+		{
+			name: "JSON with a prefix object",
+			content: `{"prefix": {"path": "/system", "target": "lab2"},
+"subscriptions": [{"path": "clock", "mode": "sample"}]}`,
+			wantPrefix: &pb.Path{Target: "lab2", Elem: []*pb.PathElem{{Name: "system"}}},
+			wantSubscriptions: []*pb.Subscription{
+				{Path: &pb.Path{Elem: []*pb.PathElem{{Name: "clock"}}}, Mode: pb.SubscriptionMode_SAMPLE},
+			},
+		},
+		{
+			name:       "Target only",
+			content:    "target: lab1\nsubscriptions: [{path: /system}]",
+			wantPrefix: &pb.Path{Target: "lab1"},
+			wantSubscriptions: []*pb.Subscription{
+				{Path: &pb.Path{Elem: []*pb.PathElem{{Name: "system"}}}},
+			},
+		},
+		{
+			name:    "Conflicting targets",
+			content: "prefix: {path: /system, target: lab2}\ntarget: lab1\nsubscriptions: [{path: clock}]",
+			wantErr: `target "lab1" differs from the target "lab2" of the prefix`,
+		},
+		{
+			name:    "No subscriptions",
+			content: "prefix: /system",
+			wantErr: "no subscriptions",
+		},
+		{
+			name:    "Missing path",
+			content: "subscriptions: [{mode: sample}]",
+			wantErr: "subscription 1: missing path",
+		},
+		{
+			name:    "Invalid mode",
+			content: "subscriptions: [{path: /system, mode: poll}]",
+			wantErr: `invalid mode "poll"`,
+		},
+		{
+			name:    "Sample interval without sample mode",
+			content: "subscriptions: [{path: /system, mode: on_change, sample_interval: 10s}]",
+			wantErr: "require mode sample",
+		},
+		{
+			name:    "Heartbeat without mode",
+			content: "subscriptions: [{path: /system, heartbeat_interval: 10s}]",
+			wantErr: "requires mode on_change or sample",
+		},
+		{
+			name:    "Invalid interval",
+			content: "subscriptions: [{path: /system, mode: sample, sample_interval: often}]",
+			wantErr: "failed to parse",
+		},
+		{
+			name:    "Unknown field",
+			content: "subscriptions: [{path: /system, mode: sample, interval: 10s}]",
+			wantErr: "field interval not found",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prefix, subscriptions, err := loadSubscriptions(writeTempFile(t, "subscriptions.yaml", test.content))
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("loadSubscriptions returned error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadSubscriptions failed: %v", err)
+			}
+			if !proto.Equal(prefix, test.wantPrefix) {
+				t.Errorf("loadSubscriptions returned prefix %v, want %v", prefix, test.wantPrefix)
+			}
+			got := &pb.SubscriptionList{Subscription: subscriptions}
+			want := &pb.SubscriptionList{Subscription: test.wantSubscriptions}
+			if !proto.Equal(got, want) {
+				t.Errorf("loadSubscriptions returned subscriptions:\n%s\nwant:\n%s", proto.MarshalTextString(got), proto.MarshalTextString(want))
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -81,6 +82,8 @@ const (
 type Server struct {
 	model    *Model
 	callback ConfigCallback
+	// startupConfig is the json config restored by Reset.
+	startupConfig []byte
 
 	config ygot.ValidatedGoStruct
 	mu     sync.RWMutex // mu is the RW lock to protect the access to config
@@ -93,9 +96,10 @@ func NewServer(model *Model, config []byte, callback ConfigCallback) (*Server, e
 		return nil, err
 	}
 	s := &Server{
-		model:    model,
-		config:   rootStruct,
-		callback: callback,
+		model:         model,
+		config:        rootStruct,
+		callback:      callback,
+		startupConfig: config,
 	}
 	if config != nil && s.callback != nil {
 		if err := s.callback(rootStruct); err != nil {
@@ -105,6 +109,30 @@ func NewServer(model *Model, config []byte, callback ConfigCallback) (*Server, e
 	return s, nil
 }
 
+// Register registers the server into the gRPC server provided.
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterGNMIServer(g, s)
+}
+
+// Reset restores the config the server was created with, dropping the changes
+// of Set requests, like a factory reset of the target. It implements the
+// Resetter of gNOI services, whose storage options don't apply.
+func (s *Server) Reset(factoryOS, zeroFill bool) error {
+	rootStruct, err := s.model.NewConfigStruct(s.startupConfig)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.startupConfig != nil && s.callback != nil {
+		if err := s.callback(rootStruct); err != nil {
+			return err
+		}
+	}
+	s.config = rootStruct
+	return nil
+}
+
 // checkEncodingAndModel checks whether encoding and models are supported by the server. Return error if anything is unsupported.
 func (s *Server) checkEncodingAndModel(encoding pb.Encoding, models []*pb.ModelData) error {
 	hasSupportedEncoding := false
@@ -17,47 +17,9 @@ limitations under the License.
 package main
 
 import (
-	"flag"
-	"fmt"
-	"time"
-
-	log "github.com/golang/glog"
-	"github.com/golang/protobuf/proto"
-	"golang.org/x/net/context"
-	"google.golang.org/grpc"
-
-	"github.com/google/gnxi/utils/credentials"
-
-	pb "github.com/openconfig/gnmi/proto/gnmi"
-)
-
-var (
-	targetAddr = flag.String("target_addr", "localhost:9339", "The target address in the format of host:port")
-	timeOut    = flag.Duration("time_out", 10*time.Second, "Timeout for the Get request, 10 seconds by default")
+	"github.com/google/gnxi/gnmi/client"
 )
 
 func main() {
-	flag.Set("logtostderr", "true")
-	flag.Parse()
-
-	opts := credentials.ClientCredentials()
-	conn, err := grpc.Dial(*targetAddr, opts...)
-	if err != nil {
-		log.Exitf("Dialing to %q failed: %v", *targetAddr, err)
-	}
-	defer conn.Close()
-
-	cli := pb.NewGNMIClient(conn)
-
-	ctx, cancel := context.WithTimeout(context.Background(), *timeOut)
-	defer cancel()
-
-	ctx = credentials.AttachToContext(ctx)
-
-	capResponse, err := cli.Capabilities(ctx, &pb.CapabilityRequest{})
-	if err != nil {
-		log.Exitf("error in getting capabilities: %v", err)
-	}
-
-	fmt.Println("== CapabilitiesResponse:\n", proto.MarshalTextString(capResponse))
+	client.Main(client.NewCapabilities())
 }
@@ -46,6 +46,7 @@ var (
 	bindAddr   = flag.String("bind_address", ":9339", "Bind to address:port or just :port")
 	configFile = flag.String("config", "", "IETF JSON file for target startup config")
 	saveOnExit = flag.Bool("save_on_exit", false, "Save the config before exiting the server.")
+	reloadCert = flag.Bool("reload_certs", false, "Reload the -cert, -key and -ca files whenever they change, keeping existing connections up.")
 )
 
 type server struct {
@@ -131,7 +132,12 @@ func main() {
 	flag.Set("logtostderr", "true")
 	flag.Parse()
 
-	opts := credentials.ServerCredentials()
+	var opts []grpc.ServerOption
+	if *reloadCert {
+		opts = credentials.ReloadingServerCredentials()
+	} else {
+		opts = credentials.ServerCredentials()
+	}
 	g := grpc.NewServer(opts...)
 
 	var configData []byte
@@ -44,6 +44,9 @@ type Info struct {
 // Notifier is called with number of Certificates and CA Certificates.
 type Notifier func(int, int)
 
+// RevokeNotifier is called with the Certificates that were revoked.
+type RevokeNotifier func([]*x509.Certificate)
+
 // Settings contains the certs and CA pool to be passed into the Manager.
 type Settings struct {
 	CertID string
@@ -59,6 +62,7 @@ type Manager struct {
 	caBundle  []*x509.Certificate
 	locks     map[string]bool
 	notifiers []Notifier
+	revokers  []RevokeNotifier
 	mu        sync.RWMutex
 }
 
@@ -124,6 +128,31 @@ func (cm *Manager) RegisterNotifier(f Notifier) {
 	cm.notifiers = append(cm.notifiers, f)
 }
 
+// RegisterRevokeNotifier registers a function that will be called with the
+// Certificates revoked by every Revoke call.
+func (cm *Manager) RegisterRevokeNotifier(f RevokeNotifier) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.revokers = append(cm.revokers, f)
+}
+
+// Reset removes all Certificates and CA Certificates and generates a new
+// private key, returning the target to bootstrapping.
+func (cm *Manager) Reset() error {
+	privateKey, err := generatePrivateKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate private key: %v", err)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.privateKey = privateKey
+	cm.certInfo = map[string]*Info{}
+	cm.caBundle = []*x509.Certificate{}
+	cm.locks = map[string]bool{}
+	go cm.notify()
+	return nil
+}
+
 func (cm *Manager) notify() {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
@@ -270,9 +299,9 @@ func (cm *Manager) GetCertInfo() ([]*Info, error) {
 // Revoke revokes Certificates.
 func (cm *Manager) Revoke(revoke []string) ([]string, map[string]string, error) {
 	cm.mu.Lock()
-	defer cm.mu.Unlock()
 
 	revoked := []string{}
+	revokedCerts := []*x509.Certificate{}
 	notRevoked := map[string]string{}
 
 	for _, certID := range revoke {
@@ -280,14 +309,24 @@ func (cm *Manager) Revoke(revoke []string) ([]string, map[string]string, error)
 			notRevoked[certID] = "an operation with this certID is in progress"
 			continue
 		}
-		if _, ok := cm.certInfo[certID]; ok {
+		if ci, ok := cm.certInfo[certID]; ok {
 			delete(cm.certInfo, certID)
 			revoked = append(revoked, certID)
+			revokedCerts = append(revokedCerts, ci.cert)
 			continue
 		}
 		notRevoked[certID] = "does not exist"
 	}
 
+	revokers := append([]RevokeNotifier{}, cm.revokers...)
+	cm.mu.Unlock()
+
+	// Revoke notifiers are called before returning so that revoked
+	// Certificates can't be used once the RPC completes, but without the lock
+	// held so that they can call the Manager.
+	for _, revoker := range revokers {
+		revoker(revokedCerts)
+	}
 	go cm.notify()
 	return revoked, notRevoked, nil
 }
@@ -389,3 +389,50 @@ func TestRevoke(t *testing.T) {
 		}
 	}
 }
+
+func TestRevokeNotifier(t *testing.T) {
+	cert := &x509.Certificate{Raw: []byte("id1")}
+	mgr := &Manager{
+		certInfo: map[string]*Info{"id1": {cert: cert}, "id2": {}},
+		locks:    map[string]bool{},
+	}
+	var got []*x509.Certificate
+	mgr.RegisterRevokeNotifier(func(certs []*x509.Certificate) {
+		// Notifiers can call the Manager.
+		mgr.GetCertInfo()
+		got = append(got, certs...)
+	})
+	if _, _, err := mgr.Revoke([]string{"id1", "id3"}); err != nil {
+		t.Fatal("Revoke:", err)
+	}
+	if len(got) != 1 || got[0] != cert {
+		t.Errorf("RevokeNotifier: got %v, want [%v]", got, cert)
+	}
+}
+
+func TestReset(t *testing.T) {
+	mgr := &Manager{
+		certInfo: map[string]*Info{"id1": {}},
+		caBundle: []*x509.Certificate{{}},
+		locks:    map[string]bool{"id1": true},
+	}
+	notified := make(chan [2]int, 1)
+	mgr.RegisterNotifier(func(certs, caCerts int) { notified <- [2]int{certs, caCerts} })
+	if err := mgr.Reset(); err != nil {
+		t.Fatal("Reset:", err)
+	}
+	if len(mgr.certInfo) != 0 || len(mgr.caBundle) != 0 || len(mgr.locks) != 0 {
+		t.Errorf("Reset: got %d certificates, %d CA certificates and %d locks, want none", len(mgr.certInfo), len(mgr.caBundle), len(mgr.locks))
+	}
+	if mgr.privateKey == nil {
+		t.Error("Reset: no private key generated")
+	}
+	select {
+	case got := <-notified:
+		if got != [2]int{0, 0} {
+			t.Errorf("Reset: notified %v, want [0 0]", got)
+		}
+	case <-time.After(time.Second):
+		t.Error("Reset: notifier not called")
+	}
+}
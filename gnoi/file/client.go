@@ -0,0 +1,153 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/gnoi/file/pb"
+	"google.golang.org/grpc"
+)
+
+// Client handles requesting File RPCs.
+type Client struct {
+	client pb.FileClient
+}
+
+// NewClient returns a new File service client.
+func NewClient(c *grpc.ClientConn) *Client {
+	return &Client{client: pb.NewFileClient(c)}
+}
+
+// Get invokes the Get RPC for the File service, writing the contents of
+// remoteFile to w and verifying their hash.
+func (c *Client) Get(ctx context.Context, remoteFile string, w io.Writer) error {
+	request := &pb.GetRequest{RemoteFile: remoteFile}
+	log.V(1).Info("GetRequest:\n", proto.MarshalTextString(request))
+	stream, err := c.client.Get(ctx, request)
+	if err != nil {
+		return err
+	}
+	h := newMultiHasher()
+	w = io.MultiWriter(w, h)
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("stream ended without the hash of %s", remoteFile)
+		}
+		if err != nil {
+			return err
+		}
+		switch resp := response.Response.(type) {
+		case *pb.GetResponse_Contents:
+			if _, err := w.Write(resp.Contents); err != nil {
+				return err
+			}
+		case *pb.GetResponse_Hash:
+			log.V(1).Info("GetResponse:\n", proto.MarshalTextString(response))
+			got, err := h.sum(resp.Hash.Method)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(got, resp.Hash.Hash) {
+				return fmt.Errorf("%s hash mismatch: got %x, want %x", resp.Hash.Method, got, resp.Hash.Hash)
+			}
+			return nil
+		default:
+			return fmt.Errorf("Unexpected response: %T(%v)", resp, resp)
+		}
+	}
+}
+
+// Put invokes the Put RPC for the File service, writing the contents of r to
+// remoteFile with the given permissions.
+func (c *Client) Put(ctx context.Context, r io.Reader, remoteFile string, permissions uint32) error {
+	stream, err := c.client.Put(ctx)
+	if err != nil {
+		return err
+	}
+	request := &pb.PutRequest{Request: &pb.PutRequest_Open{Open: &pb.PutRequest_Details{
+		RemoteFile:  remoteFile,
+		Permissions: permissions,
+	}}}
+	log.V(1).Info("PutRequest:\n", proto.MarshalTextString(request))
+	if err := stream.Send(request); err != nil {
+		return err
+	}
+	h := newHasher(pb.HashType_MD5)
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			h.Write(buf[:n])
+			if err := stream.Send(&pb.PutRequest{Request: &pb.PutRequest_Contents{Contents: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	request = &pb.PutRequest{Request: &pb.PutRequest_Hash{Hash: h.Sum()}}
+	log.V(1).Info("PutRequest:\n", proto.MarshalTextString(request))
+	if err := stream.Send(request); err != nil {
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// Stat invokes the Stat RPC for the File service.
+func (c *Client) Stat(ctx context.Context, path string) ([]*pb.StatInfo, error) {
+	request := &pb.StatRequest{Path: path}
+	log.V(1).Info("StatRequest:\n", proto.MarshalTextString(request))
+	response, err := c.client.Stat(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("StatResponse:\n", proto.MarshalTextString(response))
+	return response.Stats, nil
+}
+
+// Remove invokes the Remove RPC for the File service.
+func (c *Client) Remove(ctx context.Context, remoteFile string) error {
+	request := &pb.RemoveRequest{RemoteFile: remoteFile}
+	log.V(1).Info("RemoveRequest:\n", proto.MarshalTextString(request))
+	_, err := c.client.Remove(ctx, request)
+	return err
+}
+
+// TransferToRemote invokes the TransferToRemote RPC for the File service,
+// returning the hash of the transferred data.
+func (c *Client) TransferToRemote(ctx context.Context, localPath string, remote *pb.RemoteDownload) (*pb.HashType, error) {
+	request := &pb.TransferToRemoteRequest{LocalPath: localPath, RemoteDownload: remote}
+	// The request isn't logged as it may hold credentials.
+	log.V(1).Infof("TransferToRemoteRequest: %s to %s", localPath, remote.GetPath())
+	response, err := c.client.TransferToRemote(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("TransferToRemoteResponse:\n", proto.MarshalTextString(response))
+	return response.Hash, nil
+}
@@ -0,0 +1,68 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	"github.com/spf13/afero"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClient(t *testing.T) {
+	g := grpc.NewServer()
+	NewServer(&Settings{Fs: afero.NewMemMapFs()}).Register(g)
+	listen, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal("server failed to listen:", err)
+	}
+	go g.Serve(listen)
+	defer g.Stop()
+	conn, err := grpc.Dial(listen.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal("failed to dial:", err)
+	}
+	defer conn.Close()
+	c := NewClient(conn)
+	ctx := context.Background()
+
+	// Spans multiple messages.
+	contents := make([]byte, 3*ChunkSize+10)
+	rand.Read(contents)
+	if err := c.Put(ctx, bytes.NewReader(contents), "/tmp/image.bin", 0644); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	stats, err := c.Stat(ctx, "/tmp/image.bin")
+	if err != nil || len(stats) != 1 || stats[0].Size != uint64(len(contents)) || stats[0].Permissions != 0644 {
+		t.Errorf("Stat: got %v, %v", stats, err)
+	}
+	got := &bytes.Buffer{}
+	if err := c.Get(ctx, "/tmp/image.bin", got); err != nil || !bytes.Equal(got.Bytes(), contents) {
+		t.Errorf("Get: got %d bytes, %v, want %d bytes", got.Len(), err, len(contents))
+	}
+	if err := c.Remove(ctx, "/tmp/image.bin"); err != nil {
+		t.Errorf("Remove: %v", err)
+	}
+	if err := c.Get(ctx, "/tmp/image.bin", got); status.Code(err) != codes.NotFound {
+		t.Errorf("Get of removed file: got %v, want NotFound", err)
+	}
+}
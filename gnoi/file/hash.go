@@ -0,0 +1,75 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"io"
+
+	"github.com/google/gnxi/gnoi/file/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var hashFuncs = map[pb.HashType_HashMethod]func() hash.Hash{
+	pb.HashType_MD5:    md5.New,
+	pb.HashType_SHA256: sha256.New,
+	pb.HashType_SHA512: sha512.New,
+}
+
+// hasher computes the hash of the data written to it with a single method.
+type hasher struct {
+	hash.Hash
+	method pb.HashType_HashMethod
+}
+
+func newHasher(method pb.HashType_HashMethod) *hasher {
+	return &hasher{Hash: hashFuncs[method](), method: method}
+}
+
+// Sum returns the hash of the data written so far.
+func (h *hasher) Sum() *pb.HashType {
+	return &pb.HashType{Method: h.method, Hash: h.Hash.Sum(nil)}
+}
+
+// multiHasher computes the hash of the data written to it with every method,
+// for when the method is only known after the data.
+type multiHasher struct {
+	io.Writer
+	hashes map[pb.HashType_HashMethod]hash.Hash
+}
+
+func newMultiHasher() *multiHasher {
+	m := &multiHasher{hashes: map[pb.HashType_HashMethod]hash.Hash{}}
+	var writers []io.Writer
+	for method, f := range hashFuncs {
+		m.hashes[method] = f()
+		writers = append(writers, m.hashes[method])
+	}
+	m.Writer = io.MultiWriter(writers...)
+	return m
+}
+
+func (m *multiHasher) sum(method pb.HashType_HashMethod) ([]byte, error) {
+	h, ok := m.hashes[method]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "hash method %s is not supported", method)
+	}
+	return h.Sum(nil), nil
+}
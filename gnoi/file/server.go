@@ -0,0 +1,376 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package file implements the gNOI File service.
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/gnoi/file/pb"
+	"github.com/spf13/afero"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ChunkSize is the maximum size of the contents of a message.
+	ChunkSize          = 64 * 1024
+	defaultPermissions = 0644
+	defaultUmask       = 0022
+)
+
+// Settings for configurable options in Server.
+type Settings struct {
+	// Root is the directory holding the target's files, gnoi_file in the
+	// temporary directory by default. The absolute paths of requests are
+	// relative to it and can't escape it.
+	Root string
+	// Fs overrides the filesystem rooted in Root.
+	Fs afero.Fs
+}
+
+// Server is a File service.
+type Server struct {
+	pb.FileServer
+	fs afero.Fs
+}
+
+// NewServer returns a File service.
+func NewServer(settings *Settings) *Server {
+	fs := settings.Fs
+	if fs == nil {
+		root := settings.Root
+		if root == "" {
+			root = filepath.Join(os.TempDir(), "gnoi_file")
+		}
+		if err := os.MkdirAll(root, 0755); err != nil {
+			log.Errorf("Failed to create file root %s: %v", root, err)
+		}
+		fs = afero.NewBasePathFs(afero.NewOsFs(), root)
+	}
+	return &Server{fs: fs}
+}
+
+// Register registers the server into the gRPC server provided.
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterFileServer(g, s)
+}
+
+// Reset zero fills and removes every file of the target if zeroFill is set.
+func (s *Server) Reset(factoryOS, zeroFill bool) error {
+	if !zeroFill {
+		return nil
+	}
+	err := afero.Walk(s.fs, "/", func(p string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		return zeroFillFile(s.fs, p, info.Size())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to zero fill files: %v", err)
+	}
+	infos, err := afero.ReadDir(s.fs, "/")
+	if err != nil {
+		return fmt.Errorf("failed to remove files: %v", err)
+	}
+	for _, info := range infos {
+		if err := s.fs.RemoveAll(path.Join("/", info.Name())); err != nil {
+			return fmt.Errorf("failed to remove files: %v", err)
+		}
+	}
+	log.Info("Zero filled and removed all files")
+	return nil
+}
+
+// zeroFillFile overwrites the size bytes of the file at p with zeros.
+func zeroFillFile(fs afero.Fs, p string, size int64) error {
+	f, err := fs.OpenFile(p, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	zeros := make([]byte, ChunkSize)
+	for size > 0 {
+		n := int64(len(zeros))
+		if size < n {
+			n = size
+		}
+		if _, err := f.Write(zeros[:n]); err != nil {
+			f.Close()
+			return err
+		}
+		size -= n
+	}
+	return f.Close()
+}
+
+// checkPath returns an error if p isn't an absolute path.
+func checkPath(p string) error {
+	if !path.IsAbs(p) {
+		return status.Errorf(codes.InvalidArgument, "path %q must be absolute", p)
+	}
+	return nil
+}
+
+// fsError converts filesystem errors to gRPC status errors.
+func fsError(err error) error {
+	switch {
+	case os.IsNotExist(err):
+		return status.Error(codes.NotFound, err.Error())
+	case os.IsPermission(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Get streams the contents of a file followed by its hash.
+func (s *Server) Get(request *pb.GetRequest, stream pb.File_GetServer) error {
+	log.V(1).Info("GetRequest:\n", proto.MarshalTextString(request))
+	if err := checkPath(request.RemoteFile); err != nil {
+		return err
+	}
+	f, err := s.fs.Open(request.RemoteFile)
+	if err != nil {
+		return fsError(err)
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err != nil {
+		return fsError(err)
+	} else if info.IsDir() {
+		return status.Errorf(codes.InvalidArgument, "%q is a directory", request.RemoteFile)
+	}
+	h := newHasher(pb.HashType_MD5)
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			h.Write(buf[:n])
+			if err := stream.Send(&pb.GetResponse{Response: &pb.GetResponse_Contents{Contents: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fsError(err)
+		}
+	}
+	return stream.Send(&pb.GetResponse{Response: &pb.GetResponse_Hash{Hash: h.Sum()}})
+}
+
+// Put writes the streamed contents to a temporary file and moves it to the
+// requested file once its hash is verified.
+func (s *Server) Put(stream pb.File_PutServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("PutRequest:\n", proto.MarshalTextString(request))
+	open := request.GetOpen()
+	if open == nil {
+		return status.Error(codes.InvalidArgument, "first PutRequest must be open")
+	}
+	if err := checkPath(open.RemoteFile); err != nil {
+		return err
+	}
+	dir := path.Dir(open.RemoteFile)
+	if err := s.fs.MkdirAll(dir, 0755); err != nil {
+		return fsError(err)
+	}
+	tmp, err := afero.TempFile(s.fs, dir, "."+path.Base(open.RemoteFile)+".")
+	if err != nil {
+		return fsError(err)
+	}
+	// A partially transferred file is removed, the original file is untouched.
+	done := false
+	defer func() {
+		tmp.Close()
+		if !done {
+			s.fs.Remove(tmp.Name())
+		}
+	}()
+
+	h := newMultiHasher()
+	w := io.MultiWriter(tmp, h)
+	for verified := false; !verified; {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			return status.Error(codes.Aborted, "stream ended without the hash of the file")
+		}
+		if err != nil {
+			return err
+		}
+		switch req := request.Request.(type) {
+		case *pb.PutRequest_Contents:
+			if _, err := w.Write(req.Contents); err != nil {
+				return fsError(err)
+			}
+		case *pb.PutRequest_Hash:
+			log.V(1).Info("PutRequest:\n", proto.MarshalTextString(request))
+			got, err := h.sum(req.Hash.Method)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(got, req.Hash.Hash) {
+				return status.Errorf(codes.DataLoss, "%s hash mismatch: got %x, want %x", req.Hash.Method, got, req.Hash.Hash)
+			}
+			verified = true
+		default:
+			return status.Errorf(codes.InvalidArgument, "unexpected PutRequest %T", req)
+		}
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fsError(err)
+	}
+	permissions := os.FileMode(open.Permissions)
+	if permissions == 0 {
+		permissions = defaultPermissions
+	}
+	if err := s.fs.Chmod(tmp.Name(), permissions); err != nil {
+		return fsError(err)
+	}
+	if err := s.fs.Rename(tmp.Name(), open.RemoteFile); err != nil {
+		return fsError(err)
+	}
+	done = true
+	log.Infof("Received file %s", open.RemoteFile)
+	return stream.SendAndClose(&pb.PutResponse{})
+}
+
+// Stat returns the metadata of a file, or of the files in a directory.
+func (s *Server) Stat(ctx context.Context, request *pb.StatRequest) (*pb.StatResponse, error) {
+	if err := checkPath(request.Path); err != nil {
+		return nil, err
+	}
+	info, err := s.fs.Stat(request.Path)
+	if err != nil {
+		return nil, fsError(err)
+	}
+	if !info.IsDir() {
+		return &pb.StatResponse{Stats: []*pb.StatInfo{statInfo(request.Path, info)}}, nil
+	}
+	infos, err := afero.ReadDir(s.fs, request.Path)
+	if err != nil {
+		return nil, fsError(err)
+	}
+	response := &pb.StatResponse{}
+	for _, info := range infos {
+		response.Stats = append(response.Stats, statInfo(path.Join(request.Path, info.Name()), info))
+	}
+	return response, nil
+}
+
+func statInfo(p string, info os.FileInfo) *pb.StatInfo {
+	return &pb.StatInfo{
+		Path:         p,
+		LastModified: uint64(info.ModTime().UnixNano()),
+		Permissions:  uint32(info.Mode().Perm()),
+		Size:         uint64(info.Size()),
+		Umask:        defaultUmask,
+	}
+}
+
+// Remove removes a file.
+func (s *Server) Remove(ctx context.Context, request *pb.RemoveRequest) (*pb.RemoveResponse, error) {
+	if err := checkPath(request.RemoteFile); err != nil {
+		return nil, err
+	}
+	info, err := s.fs.Stat(request.RemoteFile)
+	if err != nil {
+		return nil, fsError(err)
+	}
+	if info.IsDir() {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is a directory", request.RemoteFile)
+	}
+	if err := s.fs.Remove(request.RemoteFile); err != nil {
+		return nil, fsError(err)
+	}
+	log.Infof("Removed file %s", request.RemoteFile)
+	return &pb.RemoveResponse{}, nil
+}
+
+// TransferToRemote uploads a file to a remote HTTP(S) server with a PUT request.
+func (s *Server) TransferToRemote(ctx context.Context, request *pb.TransferToRemoteRequest) (*pb.TransferToRemoteResponse, error) {
+	if err := checkPath(request.LocalPath); err != nil {
+		return nil, err
+	}
+	remote := request.RemoteDownload
+	if remote == nil || remote.Path == "" {
+		return nil, status.Error(codes.InvalidArgument, "remote_download path must be specified")
+	}
+	var scheme string
+	switch remote.Protocol {
+	case pb.RemoteDownload_HTTP:
+		scheme = "http://"
+	case pb.RemoteDownload_HTTPS:
+		scheme = "https://"
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "protocol %s is not supported", remote.Protocol)
+	}
+	url := remote.Path
+	if !strings.Contains(url, "://") {
+		url = scheme + url
+	}
+
+	f, err := s.fs.Open(request.LocalPath)
+	if err != nil {
+		return nil, fsError(err)
+	}
+	defer f.Close()
+	h := newHasher(pb.HashType_MD5)
+	httpRequest, err := http.NewRequest(http.MethodPut, url, io.TeeReader(f, h))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	httpRequest = httpRequest.WithContext(ctx)
+	if creds := remote.Credentials; creds != nil {
+		httpRequest.SetBasicAuth(creds.Username, creds.GetCleartext())
+	}
+	response, err := httpClient(remote.SourceAddress).Do(httpRequest)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to transfer %s: %v", request.LocalPath, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		return nil, status.Errorf(codes.Unavailable, "failed to transfer %s: %s", request.LocalPath, response.Status)
+	}
+	log.Infof("Transferred file %s to %s", request.LocalPath, url)
+	return &pb.TransferToRemoteResponse{Hash: h.Sum()}, nil
+}
+
+// httpClient returns the client used for transfers, bound to sourceAddress if
+// it's not empty.
+var httpClient = func(sourceAddress string) *http.Client {
+	if sourceAddress == "" {
+		return http.DefaultClient
+	}
+	dialer := &net.Dialer{LocalAddr: &net.TCPAddr{IP: net.ParseIP(sourceAddress)}}
+	return &http.Client{Transport: &http.Transport{DialContext: dialer.DialContext}}
+}
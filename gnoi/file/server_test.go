@@ -0,0 +1,222 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/google/gnxi/gnoi/file/pb"
+	"github.com/spf13/afero"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPutStream struct {
+	pb.File_PutServer
+	requests []*pb.PutRequest
+}
+
+func (m *mockPutStream) Recv() (*pb.PutRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := m.requests[0]
+	m.requests = m.requests[1:]
+	return request, nil
+}
+
+func (m *mockPutStream) SendAndClose(*pb.PutResponse) error {
+	return nil
+}
+
+func putRequests(remoteFile string, contents []byte, hash *pb.HashType) []*pb.PutRequest {
+	requests := []*pb.PutRequest{
+		{Request: &pb.PutRequest_Open{Open: &pb.PutRequest_Details{RemoteFile: remoteFile, Permissions: 0600}}},
+		{Request: &pb.PutRequest_Contents{Contents: contents}},
+	}
+	if hash != nil {
+		requests = append(requests, &pb.PutRequest{Request: &pb.PutRequest_Hash{Hash: hash}})
+	}
+	return requests
+}
+
+func TestPut(t *testing.T) {
+	contents := []byte("hostname target\n")
+	h := newHasher(pb.HashType_SHA256)
+	h.Write(contents)
+	tests := []struct {
+		name     string
+		requests []*pb.PutRequest
+		wantCode codes.Code
+	}{
+		{"valid hash", putRequests("/config/startup", contents, h.Sum()), codes.OK},
+		{"bad hash", putRequests("/config/startup", contents, &pb.HashType{Method: pb.HashType_SHA256, Hash: []byte("bad")}), codes.DataLoss},
+		{"unsupported hash", putRequests("/config/startup", contents, &pb.HashType{}), codes.InvalidArgument},
+		{"missing hash", putRequests("/config/startup", contents, nil), codes.Aborted},
+		{"relative path", putRequests("config/startup", contents, h.Sum()), codes.InvalidArgument},
+		{"missing open", putRequests("/config/startup", contents, h.Sum())[1:], codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			s := NewServer(&Settings{Fs: fs})
+			err := s.Put(&mockPutStream{requests: test.requests})
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("Put: got code %s (%v), want %s", got, err, test.wantCode)
+			}
+			infos, _ := afero.ReadDir(fs, "/config")
+			if test.wantCode != codes.OK {
+				if len(infos) != 0 {
+					t.Errorf("Put left files behind: %v", infos)
+				}
+				return
+			}
+			got, err := afero.ReadFile(fs, "/config/startup")
+			if err != nil || string(got) != string(contents) {
+				t.Errorf("ReadFile: got %q, %v, want %q", got, err, contents)
+			}
+			if len(infos) != 1 || infos[0].Mode().Perm() != 0600 {
+				t.Errorf("got files %v, want startup with permissions 0600", infos)
+			}
+		})
+	}
+}
+
+func TestStatAndRemove(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "/cores/core.1", []byte("core"), 0644)
+	afero.WriteFile(fs, "/cores/core.2", []byte("core"), 0644)
+	s := NewServer(&Settings{Fs: fs})
+	ctx := context.Background()
+
+	resp, err := s.Stat(ctx, &pb.StatRequest{Path: "/cores"})
+	if err != nil || len(resp.Stats) != 2 || resp.Stats[0].Path != "/cores/core.1" || resp.Stats[0].Size != 4 {
+		t.Errorf("Stat(/cores): got %v, %v", resp, err)
+	}
+	if _, err := s.Remove(ctx, &pb.RemoveRequest{RemoteFile: "/cores"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Remove(/cores): got %v, want InvalidArgument", err)
+	}
+	if _, err := s.Remove(ctx, &pb.RemoveRequest{RemoteFile: "/cores/core.1"}); err != nil {
+		t.Errorf("Remove(/cores/core.1): %v", err)
+	}
+	if _, err := s.Stat(ctx, &pb.StatRequest{Path: "/cores/core.1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Stat of removed file: got %v, want NotFound", err)
+	}
+	if _, err := s.Remove(ctx, &pb.RemoveRequest{RemoteFile: "/cores/core.1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Remove of removed file: got %v, want NotFound", err)
+	}
+}
+
+func TestSandbox(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file")
+	if err != nil {
+		t.Fatal("TempDir:", err)
+	}
+	defer os.RemoveAll(dir)
+	s := NewServer(&Settings{Root: dir})
+	contents := []byte("config")
+	h := newHasher(pb.HashType_MD5)
+	h.Write(contents)
+	if err := s.Put(&mockPutStream{requests: putRequests("/etc/config", contents, h.Sum())}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if got, err := ioutil.ReadFile(dir + "/etc/config"); err != nil || string(got) != "config" {
+		t.Errorf("file not written in root: got %q, %v", got, err)
+	}
+	if _, err := s.Stat(context.Background(), &pb.StatRequest{Path: "/../../etc/passwd"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Stat outside of root: got %v, want NotFound", err)
+	}
+}
+
+func TestTransferToRemote(t *testing.T) {
+	var got []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, _ := r.BasicAuth(); r.Method != http.MethodPut || user != "admin" || password != "secret" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		got, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "/cores/core.1", []byte("core"), 0644)
+	s := NewServer(&Settings{Fs: fs})
+	credentials := &pb.Credentials{Username: "admin", Password: &pb.Credentials_Cleartext{Cleartext: "secret"}}
+	tests := []struct {
+		name     string
+		request  *pb.TransferToRemoteRequest
+		wantCode codes.Code
+	}{
+		{"transfer", &pb.TransferToRemoteRequest{LocalPath: "/cores/core.1", RemoteDownload: &pb.RemoteDownload{Path: server.URL + "/core.1", Protocol: pb.RemoteDownload_HTTP, Credentials: credentials}}, codes.OK},
+		{"bad credentials", &pb.TransferToRemoteRequest{LocalPath: "/cores/core.1", RemoteDownload: &pb.RemoteDownload{Path: server.URL + "/core.1", Protocol: pb.RemoteDownload_HTTP}}, codes.Unavailable},
+		{"missing file", &pb.TransferToRemoteRequest{LocalPath: "/cores/core.2", RemoteDownload: &pb.RemoteDownload{Path: server.URL + "/core.2", Protocol: pb.RemoteDownload_HTTP}}, codes.NotFound},
+		{"unsupported protocol", &pb.TransferToRemoteRequest{LocalPath: "/cores/core.1", RemoteDownload: &pb.RemoteDownload{Path: "host:/core.1", Protocol: pb.RemoteDownload_SCP}}, codes.Unimplemented},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := s.TransferToRemote(context.Background(), test.request)
+			if code := status.Code(err); code != test.wantCode {
+				t.Fatalf("TransferToRemote: got code %s (%v), want %s", code, err, test.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			h := newHasher(pb.HashType_MD5)
+			h.Write([]byte("core"))
+			if string(got) != "core" || string(resp.Hash.Hash) != string(h.Sum().Hash) {
+				t.Errorf("TransferToRemote: transferred %q with hash %x", got, resp.Hash.Hash)
+			}
+		})
+	}
+}
+
+func TestReset(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "/cores/core.1", []byte("core"), 0644)
+	afero.WriteFile(fs, "/config", []byte("config"), 0644)
+	s := NewServer(&Settings{Fs: fs})
+
+	if err := s.Reset(true, false); err != nil {
+		t.Fatalf("Reset without zero fill: %v", err)
+	}
+	if ok, _ := afero.Exists(fs, "/config"); !ok {
+		t.Fatal("Reset without zero fill removed files")
+	}
+
+	// Files still open see the zeros written before the removal.
+	f, err := fs.Open("/config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := s.Reset(false, true); err != nil {
+		t.Fatalf("Reset with zero fill: %v", err)
+	}
+	if infos, _ := afero.ReadDir(fs, "/"); len(infos) != 0 {
+		t.Errorf("Reset with zero fill kept %d files", len(infos))
+	}
+	if got, _ := ioutil.ReadAll(f); !bytes.Equal(got, make([]byte, len("config"))) {
+		t.Errorf("Reset with zero fill: got contents %q, want zeros", got)
+	}
+}
@@ -20,12 +20,17 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 
 	"github.com/google/gnxi/gnoi/cert"
+	"github.com/google/gnxi/gnoi/file"
+	"github.com/google/gnxi/gnoi/healthz"
 	"github.com/google/gnxi/gnoi/os"
 	"github.com/google/gnxi/gnoi/reset"
+	"github.com/google/gnxi/gnoi/system"
 	"github.com/google/gnxi/utils/entity"
+	"github.com/google/gnxi/utils/revocation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -35,12 +40,57 @@ type Server struct {
 	certServer         *cert.Server
 	certManager        *cert.Manager
 	defaultCertificate *tls.Certificate
-	resetServer        *reset.Server
-	osServer           *os.Server
+	revocation         *revocation.Checker
+	osManager          *os.Manager
+	services           []*service
 }
 
-// NewServer returns a new server that can be used by the mock target.
-func NewServer(certSettings *cert.Settings, resetSettings *reset.Settings, notifyReset reset.Notifier, osSettings *os.Settings) (*Server, error) {
+// Settings configures the services of a Server. Nil settings leave a service
+// with its defaults.
+type Settings struct {
+	Cert    *cert.Settings
+	Reset   *reset.Settings
+	OS      *os.Settings
+	System  *system.Settings
+	File    *file.Settings
+	Healthz *healthz.Settings
+	// NotifyReset is called once factory resets wiped the target.
+	NotifyReset reset.Notifier
+	// Revocation checks client certificates, if nil only certificates revoked
+	// through the Certificate Management service are denied.
+	Revocation *revocation.Checker
+}
+
+// NewServer returns a new server that can be used by the mock target, serving
+// the Cert, Reset, OS, System, File and Healthz services followed by the ones
+// registered with RegisterService. Only the Cert service is available during
+// bootstrapping. Factory resets remove the certificates of the target, returning
+// it to bootstrapping, and wipe the services implementing Resetter before
+// calling settings.NotifyReset.
+func NewServer(settings *Settings) (*Server, error) {
+	if settings == nil {
+		settings = &Settings{}
+	}
+	certSettings, resetSettings, osSettings := settings.Cert, settings.Reset, settings.OS
+	if certSettings == nil {
+		certSettings = &cert.Settings{}
+	}
+	if resetSettings == nil {
+		resetSettings = &reset.Settings{}
+	}
+	if osSettings == nil {
+		osSettings = &os.Settings{}
+	}
+	systemSettings, fileSettings, healthzSettings := settings.System, settings.File, settings.Healthz
+	if systemSettings == nil {
+		systemSettings = &system.Settings{}
+	}
+	if fileSettings == nil {
+		fileSettings = &file.Settings{}
+	}
+	if healthzSettings == nil {
+		healthzSettings = &healthz.Settings{}
+	}
 
 	privateKey, err := rsa.GenerateKey(rand.Reader, cert.RSABitSize)
 	if err != nil {
@@ -51,18 +101,57 @@ func NewServer(certSettings *cert.Settings, resetSettings *reset.Settings, notif
 		return nil, fmt.Errorf("failed to create self signed certificate: %v", err)
 	}
 
+	revocationChecker := settings.Revocation
+	if revocationChecker == nil {
+		if revocationChecker, err = revocation.NewChecker(nil); err != nil {
+			return nil, fmt.Errorf("failed to create revocation checker: %v", err)
+		}
+	}
+
 	certManager := cert.NewManager(certSettings)
+	// Revoked target certificates must not be accepted from peers either.
+	certManager.RegisterRevokeNotifier(func(certs []*x509.Certificate) {
+		revocationChecker.Deny("revoked by Certificate Management", certs...)
+	})
 	certServer := cert.NewServer(certManager)
-	resetServer := reset.NewServer(resetSettings, notifyReset)
+	resetServer := reset.NewServer(resetSettings, settings.NotifyReset)
 	osServer := os.NewServer(osSettings)
+	// Reboots of the System service boot the OS activated through the OS service.
+	systemServer := system.NewServer(systemSettings, osServer.Manager())
+	fileServer := file.NewServer(fileSettings)
+	healthzServer, err := healthz.NewServer(healthzSettings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Healthz server: %v", err)
+	}
 
-	return &Server{
+	s := &Server{
 		certServer:         certServer,
 		certManager:        certManager,
 		defaultCertificate: e.Certificate,
-		resetServer:        resetServer,
-		osServer:           osServer,
-	}, nil
+		revocation:         revocationChecker,
+		osManager:          osServer.Manager(),
+	}
+	for _, builtin := range []struct {
+		name          string
+		service       Service
+		bootstrapping bool
+	}{
+		{"cert", certServer, true},
+		{"reset", resetServer, false},
+		{"os", osServer, false},
+		{"system", systemServer, false},
+		{"file", fileServer, false},
+		{"healthz", healthzServer, false},
+	} {
+		if err := s.AddService(builtin.name, builtin.service, builtin.bootstrapping); err != nil {
+			return nil, fmt.Errorf("failed to add %s service: %v", builtin.name, err)
+		}
+	}
+	if err := s.addRegisteredServices(); err != nil {
+		return nil, err
+	}
+	resetServer.RegisterWiper(s.reset)
+	return s, nil
 }
 
 // PrepareEncrypted prepares a gRPC server with the CertificateManagement service
@@ -74,7 +163,7 @@ func (s *Server) PrepareEncrypted() *grpc.Server {
 		Certificates: []tls.Certificate{*s.defaultCertificate},
 		ClientCAs:    nil,
 	}))}
-	return grpc.NewServer(opts...)
+	return grpc.NewServer(append(opts, s.rebootInterceptors()...)...)
 }
 
 // PrepareAuthenticated prepares a gRPC server with the CertificateManagement service
@@ -83,23 +172,32 @@ func (s *Server) PrepareAuthenticated() *grpc.Server {
 	config := func(*tls.ClientHelloInfo) (*tls.Config, error) {
 		tlsCerts, x509Pool := s.certManager.TLSCertificates()
 		return &tls.Config{
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			Certificates: tlsCerts,
-			ClientCAs:    x509Pool,
+			ClientAuth:            tls.RequireAndVerifyClientCert,
+			Certificates:          tlsCerts,
+			ClientCAs:             x509Pool,
+			VerifyPeerCertificate: s.revocation.VerifyPeerCertificate,
 		}, nil
 	}
 	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(&tls.Config{GetConfigForClient: config}))}
-	return grpc.NewServer(opts...)
+	return grpc.NewServer(append(opts, s.rebootInterceptors()...)...)
 }
 
-// Register all implemented gRPC services.
-func (s *Server) Register(g *grpc.Server) {
-	s.certServer.Register(g)
-	s.resetServer.Register(g)
-	s.osServer.Register(g)
+// Reboot reboots the target into the active OS version. It is unavailable
+// while it boots.
+func (s *Server) Reboot() {
+	s.osManager.Reboot()
+}
+
+// rebootInterceptors fail every RPC with Unavailable while the target reboots.
+func (s *Server) rebootInterceptors() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(s.osManager.UnaryInterceptor),
+		grpc.StreamInterceptor(s.osManager.StreamInterceptor),
+	}
 }
 
 // RegCertificateManagement registers only the Certificate Management service in the gRPC Server.
+// Use RegisterBootstrapping to also register other services available during bootstrapping.
 func (s *Server) RegCertificateManagement(g *grpc.Server) {
 	s.certServer.Register(g)
 }
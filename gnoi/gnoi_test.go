@@ -16,21 +16,40 @@ limitations under the License.
 package gnoi
 
 import (
+	"context"
+	"errors"
 	"net"
+	"reflect"
+	"strings"
 	"testing"
 
-	"github.com/google/gnxi/gnoi/cert"
-	"github.com/google/gnxi/gnoi/os"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/google/gnxi/gnmi"
+	"github.com/google/gnxi/gnmi/modeldata"
+	"github.com/google/gnxi/gnmi/modeldata/gostruct"
+	"github.com/google/gnxi/gnoi/file"
 	"github.com/google/gnxi/gnoi/reset"
-)
+	"github.com/spf13/afero"
+	"google.golang.org/grpc"
 
-func TestServer(t *testing.T) {
-	conString := "localhost:4456"
+	resetpb "github.com/google/gnxi/gnoi/reset/pb"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
 
-	s, err := NewServer(&cert.Settings{}, &reset.Settings{}, func() {}, &os.Settings{})
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	s, err := NewServer(&Settings{File: &file.Settings{Fs: afero.NewMemMapFs()}})
 	if err != nil {
 		t.Fatal("failed to Create Server:", err)
 	}
+	return s
+}
+
+func TestServer(t *testing.T) {
+	conString := "localhost:4456"
+
+	s := newTestServer(t)
 
 	g := s.PrepareEncrypted()
 	s.RegCertificateManagement(g)
@@ -52,3 +71,131 @@ func TestServer(t *testing.T) {
 	g.GracefulStop()
 	listen.Close()
 }
+
+type fakeService struct {
+	registered int
+}
+
+func (f *fakeService) Register(g *grpc.Server) {
+	f.registered++
+}
+
+func TestAddService(t *testing.T) {
+	s := newTestServer(t)
+	bootstrap, provisioned := &fakeService{}, &fakeService{}
+	if err := s.AddService("containerz", provisioned, false); err != nil {
+		t.Fatalf("AddService(containerz): %v", err)
+	}
+	if err := s.AddService("plq", bootstrap, true); err != nil {
+		t.Fatalf("AddService(plq): %v", err)
+	}
+	if err := s.AddService("os", &fakeService{}, false); err == nil {
+		t.Error("AddService(os): got nil error, want error for a duplicate service")
+	}
+	want := []string{"cert", "reset", "os", "system", "file", "healthz", "containerz", "plq"}
+	if diff := cmp.Diff(want, s.Services()); diff != "" {
+		t.Errorf("Services(): (-want +got):\n%s", diff)
+	}
+
+	s.RegisterBootstrapping(s.PrepareEncrypted())
+	if bootstrap.registered != 1 || provisioned.registered != 0 {
+		t.Errorf("RegisterBootstrapping: got %d bootstrapping and %d other registrations, want 1 and 0", bootstrap.registered, provisioned.registered)
+	}
+	s.Register(s.PrepareAuthenticated())
+	if bootstrap.registered != 2 || provisioned.registered != 1 {
+		t.Errorf("Register: got %d bootstrapping and %d other registrations, want 2 and 1", bootstrap.registered, provisioned.registered)
+	}
+}
+
+func TestRegisterService(t *testing.T) {
+	defer func(f []*factory) { factories = f }(factories)
+	created := 0
+	RegisterService("containerz", false, func() (Service, error) {
+		created++
+		return &fakeService{}, nil
+	})
+	for i := 1; i <= 2; i++ {
+		s := newTestServer(t)
+		if got := s.Services(); got[len(got)-1] != "containerz" {
+			t.Errorf("Services(): got %v, want containerz last", got)
+		}
+		if created != i {
+			t.Errorf("got %d services created for %d servers", created, i)
+		}
+	}
+	RegisterService("os", false, func() (Service, error) { return &fakeService{}, nil })
+	if _, err := NewServer(nil); err == nil {
+		t.Error("NewServer: got nil error, want error for a service clashing with the OS service")
+	}
+}
+
+type resettableService struct {
+	fakeService
+	factoryOS, zeroFill bool
+	err                 error
+}
+
+func (r *resettableService) Reset(factoryOS, zeroFill bool) error {
+	r.factoryOS, r.zeroFill = factoryOS, zeroFill
+	return r.err
+}
+
+func TestReset(t *testing.T) {
+	s := newTestServer(t)
+	containerz := &resettableService{}
+	plq := &resettableService{err: errors.New("qualification in progress")}
+	s.AddService("containerz", containerz, false)
+	s.AddService("plq", plq, false)
+	s.osManager.Install("1.0.1a", "")
+
+	err := s.reset(true, true)
+	if want := "plq: qualification in progress"; err == nil || err.Error() != want {
+		t.Errorf("reset: got error %v, want %q", err, want)
+	}
+	if !containerz.factoryOS || !containerz.zeroFill {
+		t.Errorf("reset: service reset with factoryOS %v and zeroFill %v, want true", containerz.factoryOS, containerz.zeroFill)
+	}
+	if s.osManager.IsInstalled("1.0.1a") {
+		t.Error("reset with factory OS kept OS version 1.0.1a installed")
+	}
+	if certs, caCerts := s.certManager.TLSCertificates(); len(certs) != 0 || len(caCerts.Subjects()) != 0 {
+		t.Errorf("reset kept %d certificates", len(certs))
+	}
+}
+
+func TestResetGNMI(t *testing.T) {
+	model := gnmi.NewModel(modeldata.ModelData,
+		reflect.TypeOf((*gostruct.Device)(nil)),
+		gostruct.SchemaTree["Device"],
+		gostruct.Unmarshal,
+		gostruct.ΛEnum)
+	gNMIServer, err := gnmi.NewServer(model, []byte(`{"system": {"config": {"hostname": "startup"}}}`), nil)
+	if err != nil {
+		t.Fatalf("failed to create gNMI server: %v", err)
+	}
+	s := newTestServer(t)
+	s.AddService("gnmi", gNMIServer, false)
+	path := &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "system"}, {Name: "config"}, {Name: "domain-name"}}}
+	val := &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: "set.example.com"}}
+	if _, err := gNMIServer.Set(context.Background(), &gnmipb.SetRequest{Update: []*gnmipb.Update{{Path: path, Val: val}}}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	var resetServer *reset.Server
+	for _, registered := range s.services {
+		if registered.name == "reset" {
+			resetServer = registered.service.(*reset.Server)
+		}
+	}
+	resp, err := resetServer.Start(context.Background(), &resetpb.StartRequest{})
+	if err != nil || resp.GetResetError() != nil {
+		t.Fatalf("Start: got error %v, reset error %v", err, resp.GetResetError())
+	}
+	config, err := gNMIServer.ConfigAsJSON()
+	if err != nil {
+		t.Fatalf("ConfigAsJSON failed: %v", err)
+	}
+	if strings.Contains(config, "set.example.com") || !strings.Contains(config, "startup") {
+		t.Errorf("Start: got config %s, want the startup config", config)
+	}
+}
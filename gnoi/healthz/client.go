@@ -0,0 +1,137 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/gnoi/healthz/pb"
+	"github.com/google/gnxi/gnoi/system"
+	"google.golang.org/grpc"
+)
+
+// Client handles requesting Healthz RPCs.
+type Client struct {
+	client pb.HealthzClient
+}
+
+// NewClient returns a new Healthz service client.
+func NewClient(c *grpc.ClientConn) *Client {
+	return &Client{client: pb.NewHealthzClient(c)}
+}
+
+// Get invokes the Get RPC for the Healthz service, returning the status of the
+// named component and its subcomponents.
+func (c *Client) Get(ctx context.Context, name string) (*pb.ComponentStatus, error) {
+	request := &pb.GetRequest{Path: system.ComponentPath(name)}
+	log.V(1).Info("GetRequest:\n", proto.MarshalTextString(request))
+	response, err := c.client.Get(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("GetResponse:\n", proto.MarshalTextString(response))
+	return response.Component, nil
+}
+
+// List invokes the List RPC for the Healthz service, returning the health
+// events of the named component.
+func (c *Client) List(ctx context.Context, name string, includeAcknowledged bool) ([]*pb.ComponentStatus, error) {
+	request := &pb.ListRequest{Path: system.ComponentPath(name), IncludeAcknowledged: includeAcknowledged}
+	log.V(1).Info("ListRequest:\n", proto.MarshalTextString(request))
+	response, err := c.client.List(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("ListResponse:\n", proto.MarshalTextString(response))
+	return response.Statuses, nil
+}
+
+// Acknowledge invokes the Acknowledge RPC for the Healthz service.
+func (c *Client) Acknowledge(ctx context.Context, name, id string) (*pb.ComponentStatus, error) {
+	request := &pb.AcknowledgeRequest{Path: system.ComponentPath(name), Id: id}
+	log.V(1).Info("AcknowledgeRequest:\n", proto.MarshalTextString(request))
+	response, err := c.client.Acknowledge(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, nil
+}
+
+// Check invokes the Check RPC for the Healthz service, optionally checking a
+// previous event again.
+func (c *Client) Check(ctx context.Context, name, eventID string) (*pb.ComponentStatus, error) {
+	request := &pb.CheckRequest{Path: system.ComponentPath(name), EventId: eventID}
+	log.V(1).Info("CheckRequest:\n", proto.MarshalTextString(request))
+	response, err := c.client.Check(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("CheckResponse:\n", proto.MarshalTextString(response))
+	return response.Status, nil
+}
+
+// Artifact invokes the Artifact RPC for the Healthz service, writing the
+// contents of the artifact to w and verifying the hash of file artifacts.
+func (c *Client) Artifact(ctx context.Context, id string, w io.Writer) (*pb.ArtifactHeader, error) {
+	request := &pb.ArtifactRequest{Id: id}
+	log.V(1).Info("ArtifactRequest:\n", proto.MarshalTextString(request))
+	stream, err := c.client.Artifact(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	var header *pb.ArtifactHeader
+	h := sha256.New()
+	w = io.MultiWriter(w, h)
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil, fmt.Errorf("stream ended without the trailer of artifact %s", id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch resp := response.Contents.(type) {
+		case *pb.ArtifactResponse_Header:
+			log.V(1).Info("ArtifactResponse:\n", proto.MarshalTextString(response))
+			header = resp.Header
+		case *pb.ArtifactResponse_Bytes:
+			if header == nil {
+				return nil, fmt.Errorf("artifact %s contents received before its header", id)
+			}
+			if _, err := w.Write(resp.Bytes); err != nil {
+				return nil, err
+			}
+		case *pb.ArtifactResponse_Trailer:
+			if header == nil {
+				return nil, fmt.Errorf("artifact %s trailer received before its header", id)
+			}
+			if hash := header.GetFile().GetHash(); hash.GetMethod() == pb.HashType_SHA256 {
+				if got := h.Sum(nil); !bytes.Equal(got, hash.Hash) {
+					return nil, fmt.Errorf("SHA256 hash mismatch: got %x, want %x", got, hash.Hash)
+				}
+			}
+			return header, nil
+		default:
+			return nil, fmt.Errorf("Unexpected response: %T(%v)", resp, resp)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthz
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/gnxi/gnoi/healthz/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClient(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	g := grpc.NewServer()
+	s.Register(g)
+	listen, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal("server failed to listen:", err)
+	}
+	go g.Serve(listen)
+	defer g.Stop()
+	conn, err := grpc.Dial(listen.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal("failed to dial:", err)
+	}
+	defer conn.Close()
+	c := NewClient(conn)
+	ctx := context.Background()
+
+	cs, err := c.Get(ctx, "linecard0")
+	if err != nil || cs.Status != pb.Status_STATUS_UNHEALTHY || len(cs.Artifacts) != 1 {
+		t.Fatalf("Get: got %v, %v, want unhealthy with an artifact", cs, err)
+	}
+	got := &bytes.Buffer{}
+	header, err := c.Artifact(ctx, cs.Artifacts[0].Id, got)
+	if err != nil || got.String() != "core dump" || header.GetFile().GetName() != "linecard0.core" {
+		t.Errorf("Artifact: got %v %q, %v, want linecard0.core", header, got, err)
+	}
+	if _, err := c.Artifact(ctx, "unknown", got); status.Code(err) != codes.NotFound {
+		t.Errorf("Artifact(unknown): got %v, want NotFound", err)
+	}
+	if _, err := c.Acknowledge(ctx, "linecard0", cs.Id); err != nil {
+		t.Errorf("Acknowledge: %v", err)
+	}
+	if statuses, err := c.List(ctx, "linecard0", true); err != nil || len(statuses) != 1 || !statuses[0].Acknowledged {
+		t.Errorf("List: got %v, %v, want the acknowledged event", statuses, err)
+	}
+	if cs, err := c.Check(ctx, "linecard0", cs.Id); err != nil || cs.Status != pb.Status_STATUS_HEALTHY {
+		t.Errorf("Check: got %v, %v, want healthy", cs, err)
+	}
+}
@@ -0,0 +1,167 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthz
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/gnxi/gnoi/healthz/pb"
+	"gopkg.in/yaml.v2"
+)
+
+// Model is the fault model of the target: the components of its platform tree
+// and their health events.
+type Model struct {
+	Components []*Component `yaml:"components"`
+}
+
+// Component is a component of the platform tree,
+// /components/component[name=<Name>].
+type Component struct {
+	Name string `yaml:"name"`
+	// Parent is the name of the component containing this one, if any.
+	Parent string `yaml:"parent"`
+	// Events are the health events the component had before the target started,
+	// oldest first. Components without events are healthy.
+	Events []*Event `yaml:"events"`
+	// Checks are the health events raised by successive Check requests. Checks
+	// past the last one find the component healthy.
+	Checks []*Event `yaml:"checks"`
+}
+
+// Event is a health event of a component.
+type Event struct {
+	ID string `yaml:"id"`
+	// Status is either healthy or unhealthy.
+	Status string `yaml:"status"`
+	// Created is when the event happened, by default when the target started
+	// or, for checks, when the check ran.
+	Created time.Time `yaml:"created"`
+	// ExpiresAfter is how long after it's created the event expires, never by
+	// default. Expired events and their artifacts are no longer reported.
+	ExpiresAfter time.Duration `yaml:"expires_after"`
+	Artifacts    []*Artifact   `yaml:"artifacts"`
+}
+
+// Artifact is a file collected with a health event.
+type Artifact struct {
+	ID string `yaml:"id"`
+	// File is the path of the artifact, relative to the model file.
+	File string `yaml:"file"`
+}
+
+// DefaultModel is used when no model file is configured: a healthy chassis
+// holding the control processors of the System service.
+var DefaultModel = &Model{Components: []*Component{
+	{Name: "chassis"},
+	{Name: "RP0", Parent: "chassis"},
+	{Name: "RP1", Parent: "chassis"},
+}}
+
+// LoadModel reads a YAML fault model from file.
+func LoadModel(file string) (*Model, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model: %v", err)
+	}
+	model := &Model{}
+	if err := yaml.UnmarshalStrict(data, model); err != nil {
+		return nil, fmt.Errorf("failed to parse model %s: %v", file, err)
+	}
+	dir := filepath.Dir(file)
+	for _, c := range model.Components {
+		for _, e := range append(c.Events, c.Checks...) {
+			for _, a := range e.Artifacts {
+				if a.File != "" && !filepath.IsAbs(a.File) {
+					a.File = filepath.Join(dir, a.File)
+				}
+			}
+		}
+	}
+	if err := model.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid model %s: %v", file, err)
+	}
+	return model, nil
+}
+
+// Validate checks that components and the ids of events and artifacts are
+// unique, that parents exist and that statuses are known.
+func (m *Model) Validate() error {
+	components := map[string]bool{}
+	for _, c := range m.Components {
+		if c.Name == "" {
+			return fmt.Errorf("component without a name")
+		}
+		if components[c.Name] {
+			return fmt.Errorf("duplicate component %q", c.Name)
+		}
+		components[c.Name] = true
+	}
+	parents := map[string]string{}
+	for _, c := range m.Components {
+		if c.Parent != "" && !components[c.Parent] {
+			return fmt.Errorf("component %q has unknown parent %q", c.Name, c.Parent)
+		}
+		parents[c.Name] = c.Parent
+	}
+	for _, c := range m.Components {
+		for p, depth := c.Parent, 0; p != ""; p, depth = parents[p], depth+1 {
+			if p == c.Name || depth > len(parents) {
+				return fmt.Errorf("component %q is its own parent", c.Name)
+			}
+		}
+	}
+	events := map[string]bool{}
+	artifacts := map[string]bool{}
+	for _, c := range m.Components {
+		for _, e := range append(c.Events, c.Checks...) {
+			if e.ID == "" {
+				return fmt.Errorf("event of component %q without an id", c.Name)
+			}
+			if events[e.ID] {
+				return fmt.Errorf("duplicate event %q", e.ID)
+			}
+			events[e.ID] = true
+			if _, err := parseStatus(e.Status); err != nil {
+				return fmt.Errorf("event %q: %v", e.ID, err)
+			}
+			for _, a := range e.Artifacts {
+				if a.ID == "" || a.File == "" {
+					return fmt.Errorf("artifact of event %q needs an id and a file", e.ID)
+				}
+				if artifacts[a.ID] {
+					return fmt.Errorf("duplicate artifact %q", a.ID)
+				}
+				artifacts[a.ID] = true
+			}
+		}
+	}
+	return nil
+}
+
+func parseStatus(s string) (pb.Status, error) {
+	switch strings.ToLower(s) {
+	case "healthy":
+		return pb.Status_STATUS_HEALTHY, nil
+	case "unhealthy":
+		return pb.Status_STATUS_UNHEALTHY, nil
+	}
+	return pb.Status_STATUS_UNSPECIFIED, fmt.Errorf("status %q must be healthy or unhealthy", s)
+}
@@ -0,0 +1,355 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package healthz implements the gNOI Healthz service, simulating the health
+// of components with a fault model.
+package healthz
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/gnoi/healthz/pb"
+	"github.com/google/gnxi/gnoi/system"
+	gnmiPb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ChunkSize is the maximum size of the contents of an artifact message.
+const ChunkSize = 64 * 1024
+
+var now = time.Now
+
+// Settings for configurable options in Server.
+type Settings struct {
+	// ModelFile is a YAML fault model, see Model.
+	ModelFile string
+	// Model overrides ModelFile, DefaultModel is used if neither is set.
+	Model *Model
+}
+
+// event is a health event raised on a component.
+type event struct {
+	*Event
+	status       pb.Status
+	created      time.Time
+	acknowledged bool
+}
+
+func (e *event) expired() bool {
+	return e.ExpiresAfter > 0 && !now().Before(e.created.Add(e.ExpiresAfter))
+}
+
+type component struct {
+	name     string
+	children []string
+	// events are the events raised on the component, oldest first.
+	events []*event
+	// checks are the events left to raise with Check.
+	checks []*Event
+	// checked counts the Check requests for the component.
+	checked int
+}
+
+// Server is a Healthz service.
+type Server struct {
+	pb.HealthzServer
+	mu         sync.Mutex
+	components map[string]*component
+}
+
+// NewServer returns a Healthz service simulating settings' fault model.
+func NewServer(settings *Settings) (*Server, error) {
+	model := settings.Model
+	if model == nil && settings.ModelFile != "" {
+		var err error
+		if model, err = LoadModel(settings.ModelFile); err != nil {
+			return nil, err
+		}
+	}
+	if model == nil {
+		model = DefaultModel
+	}
+	if err := model.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid model: %v", err)
+	}
+	start := now()
+	s := &Server{components: map[string]*component{}}
+	for _, c := range model.Components {
+		s.components[c.Name] = &component{name: c.Name, checks: c.Checks}
+	}
+	for _, c := range model.Components {
+		comp := s.components[c.Name]
+		if c.Parent != "" {
+			parent := s.components[c.Parent]
+			parent.children = append(parent.children, c.Name)
+		}
+		for _, e := range c.Events {
+			comp.events = append(comp.events, newEvent(e, start))
+		}
+		if len(comp.events) == 0 {
+			comp.events = append(comp.events, newEvent(&Event{ID: c.Name + "-healthy", Status: "healthy"}, start))
+		}
+	}
+	return s, nil
+}
+
+// newEvent returns the event raised for e, created at t unless e says otherwise.
+func newEvent(e *Event, t time.Time) *event {
+	st, _ := parseStatus(e.Status)
+	if !e.Created.IsZero() {
+		t = e.Created
+	}
+	return &event{Event: e, status: st, created: t}
+}
+
+// Register registers the server into the gRPC server provided.
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterHealthzServer(g, s)
+}
+
+// component returns the component at path.
+func (s *Server) component(path *gnmiPb.Path) (*component, error) {
+	name := system.ComponentName(path)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "path %v is not a component path", path)
+	}
+	c, ok := s.components[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "component %q not found", name)
+	}
+	return c, nil
+}
+
+// latest returns the latest event of c which hasn't expired, nil if none.
+func (c *component) latest() *event {
+	for i := len(c.events) - 1; i >= 0; i-- {
+		if !c.events[i].expired() {
+			return c.events[i]
+		}
+	}
+	return nil
+}
+
+// event returns the event of c with the given id which hasn't expired.
+func (c *component) event(id string) (*event, error) {
+	for _, e := range c.events {
+		if e.ID == id && !e.expired() {
+			return e, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "event %q of component %q not found", id, c.name)
+}
+
+// componentStatus returns the status of c as of e.
+func (c *component) componentStatus(e *event) *pb.ComponentStatus {
+	cs := &pb.ComponentStatus{
+		Path:   system.ComponentPath(c.name),
+		Status: pb.Status_STATUS_HEALTHY,
+	}
+	if e == nil {
+		return cs
+	}
+	cs.Status = e.status
+	cs.Id = e.ID
+	cs.Acknowledged = e.acknowledged
+	cs.Created = timestamppb.New(e.created)
+	if e.ExpiresAfter > 0 {
+		cs.Expires = timestamppb.New(e.created.Add(e.ExpiresAfter))
+	}
+	for _, a := range e.Artifacts {
+		header, err := artifactHeader(a)
+		if err != nil {
+			log.Errorf("Failed to read artifact %s: %v", a.ID, err)
+			continue
+		}
+		cs.Artifacts = append(cs.Artifacts, header)
+	}
+	return cs
+}
+
+// tree returns the latest status of c and of its subcomponents.
+func (s *Server) tree(c *component) *pb.ComponentStatus {
+	cs := c.componentStatus(c.latest())
+	for _, child := range c.children {
+		cs.Subcomponents = append(cs.Subcomponents, s.tree(s.components[child]))
+	}
+	return cs
+}
+
+// Get returns the latest health status of a component and its subcomponents.
+func (s *Server) Get(ctx context.Context, request *pb.GetRequest) (*pb.GetResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, err := s.component(request.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetResponse{Component: s.tree(c)}, nil
+}
+
+// List returns the health events of a component, acknowledged ones only if
+// requested.
+func (s *Server) List(ctx context.Context, request *pb.ListRequest) (*pb.ListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, err := s.component(request.Path)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListResponse{}
+	for _, e := range c.events {
+		if e.expired() || (e.acknowledged && !request.IncludeAcknowledged) {
+			continue
+		}
+		response.Statuses = append(response.Statuses, c.componentStatus(e))
+	}
+	return response, nil
+}
+
+// Acknowledge marks a health event as acknowledged.
+func (s *Server) Acknowledge(ctx context.Context, request *pb.AcknowledgeRequest) (*pb.AcknowledgeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, err := s.component(request.Path)
+	if err != nil {
+		return nil, err
+	}
+	e, err := c.event(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	e.acknowledged = true
+	log.Infof("Acknowledged event %s of %s", e.ID, c.name)
+	return &pb.AcknowledgeResponse{Status: c.componentStatus(e)}, nil
+}
+
+// Check raises the next check event of the component, or a healthy event once
+// the model's checks are exhausted.
+func (s *Server) Check(ctx context.Context, request *pb.CheckRequest) (*pb.CheckResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, err := s.component(request.Path)
+	if err != nil {
+		return nil, err
+	}
+	if request.EventId != "" {
+		if _, err := c.event(request.EventId); err != nil {
+			return nil, err
+		}
+	}
+	c.checked++
+	var e *event
+	if len(c.checks) > 0 {
+		e = newEvent(c.checks[0], now())
+		c.checks = c.checks[1:]
+	} else {
+		e = newEvent(&Event{ID: fmt.Sprintf("%s-check-%d", c.name, c.checked), Status: "healthy"}, now())
+	}
+	c.events = append(c.events, e)
+	log.Infof("Checked %s: %s", c.name, e.status)
+	return &pb.CheckResponse{Status: c.componentStatus(e)}, nil
+}
+
+// artifact returns the artifact with the given id of an event which hasn't
+// expired.
+func (s *Server) artifact(id string) (*Artifact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.components {
+		for _, e := range c.events {
+			if e.expired() {
+				continue
+			}
+			for _, a := range e.Artifacts {
+				if a.ID == id {
+					return a, nil
+				}
+			}
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "artifact %q not found", id)
+}
+
+// artifactHeader returns the header of a, with the size and SHA256 hash of its
+// file.
+func artifactHeader(a *Artifact) (*pb.ArtifactHeader, error) {
+	f, err := os.Open(a.File)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ArtifactHeader{
+		Id: a.ID,
+		ArtifactType: &pb.ArtifactHeader_File{File: &pb.FileArtifactType{
+			Name: filepath.Base(a.File),
+			Size: size,
+			Hash: &pb.HashType{Method: pb.HashType_SHA256, Hash: h.Sum(nil)},
+		}},
+	}, nil
+}
+
+// Artifact streams the header, contents and trailer of an artifact.
+func (s *Server) Artifact(request *pb.ArtifactRequest, stream pb.Healthz_ArtifactServer) error {
+	log.V(1).Info("ArtifactRequest:\n", proto.MarshalTextString(request))
+	a, err := s.artifact(request.Id)
+	if err != nil {
+		return err
+	}
+	header, err := artifactHeader(a)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read artifact %q: %v", a.ID, err)
+	}
+	if err := stream.Send(&pb.ArtifactResponse{Contents: &pb.ArtifactResponse_Header{Header: header}}); err != nil {
+		return err
+	}
+	f, err := os.Open(a.File)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read artifact %q: %v", a.ID, err)
+	}
+	defer f.Close()
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.ArtifactResponse{Contents: &pb.ArtifactResponse_Bytes{Bytes: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read artifact %q: %v", a.ID, err)
+		}
+	}
+	return stream.Send(&pb.ArtifactResponse{Contents: &pb.ArtifactResponse_Trailer{Trailer: &pb.ArtifactTrailer{}}})
+}
@@ -0,0 +1,215 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthz
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/gnxi/gnoi/healthz/pb"
+	"github.com/google/gnxi/gnoi/system"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testModel = `
+components:
+- name: chassis
+- name: linecard0
+  parent: chassis
+  events:
+  - id: linecard0-parity
+    status: unhealthy
+    artifacts:
+    - id: linecard0-core
+      file: linecard0.core
+- name: fan0
+  parent: chassis
+  events:
+  - id: fan0-stall
+    status: unhealthy
+    expires_after: 1h
+  checks:
+  - id: fan0-check
+    status: unhealthy
+`
+
+// writeModel writes testModel and its artifact to a temporary directory,
+// returning the model file.
+func writeModel(t *testing.T) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "model.yaml")
+	if err := ioutil.WriteFile(file, []byte(testModel), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "linecard0.core"), []byte("core dump"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file, func() { os.RemoveAll(dir) }
+}
+
+func newTestServer(t *testing.T) (*Server, func()) {
+	t.Helper()
+	file, cleanup := writeModel(t)
+	s, err := NewServer(&Settings{ModelFile: file})
+	if err != nil {
+		cleanup()
+		t.Fatalf("NewServer: %v", err)
+	}
+	return s, cleanup
+}
+
+func TestLoadModelErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		model string
+	}{
+		{"unknown field", "components:\n- name: chassis\n  state: up\n"},
+		{"duplicate component", "components:\n- name: chassis\n- name: chassis\n"},
+		{"unknown parent", "components:\n- name: fan0\n  parent: chassis\n"},
+		{"parent cycle", "components:\n- name: a\n  parent: b\n- name: b\n  parent: a\n"},
+		{"unknown status", "components:\n- name: fan0\n  events:\n  - id: e\n    status: degraded\n"},
+		{"duplicate event", "components:\n- name: fan0\n  events:\n  - {id: e, status: healthy}\n  checks:\n  - {id: e, status: healthy}\n"},
+	}
+	dir, err := ioutil.TempDir("", "healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file := filepath.Join(dir, "model.yaml")
+			if err := ioutil.WriteFile(file, []byte(test.model), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadModel(file); err == nil {
+				t.Error("LoadModel: got nil error, want error")
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	resp, err := s.Get(context.Background(), &pb.GetRequest{Path: system.ComponentPath("chassis")})
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got := map[string]pb.Status{}
+	var walk func(*pb.ComponentStatus)
+	walk = func(cs *pb.ComponentStatus) {
+		got[system.ComponentName(cs.Path)] = cs.Status
+		for _, sub := range cs.Subcomponents {
+			walk(sub)
+		}
+	}
+	walk(resp.Component)
+	want := map[string]pb.Status{
+		"chassis":   pb.Status_STATUS_HEALTHY,
+		"linecard0": pb.Status_STATUS_UNHEALTHY,
+		"fan0":      pb.Status_STATUS_UNHEALTHY,
+	}
+	for name, st := range want {
+		if got[name] != st {
+			t.Errorf("status of %s: got %s, want %s", name, got[name], st)
+		}
+	}
+	lc := resp.Component.Subcomponents[0]
+	if len(lc.Artifacts) != 1 || lc.Artifacts[0].GetFile().GetSize() != int64(len("core dump")) {
+		t.Errorf("linecard0 artifacts: got %v, want the core file", lc.Artifacts)
+	}
+
+	if _, err := s.Get(context.Background(), &pb.GetRequest{Path: system.ComponentPath("fan9")}); status.Code(err) != codes.NotFound {
+		t.Errorf("Get(fan9): got %v, want NotFound", err)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	defer func(f func() time.Time) { now = f }(now)
+	later := time.Now().Add(2 * time.Hour)
+	now = func() time.Time { return later }
+
+	resp, err := s.Get(context.Background(), &pb.GetRequest{Path: system.ComponentPath("fan0")})
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if resp.Component.Status != pb.Status_STATUS_HEALTHY || resp.Component.Id != "" {
+		t.Errorf("Get after expiry: got %v, want healthy without event", resp.Component)
+	}
+}
+
+func TestAcknowledgeAndList(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	path := system.ComponentPath("linecard0")
+	if _, err := s.Acknowledge(ctx, &pb.AcknowledgeRequest{Path: path, Id: "fan0-stall"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Acknowledge of another component's event: got %v, want NotFound", err)
+	}
+	resp, err := s.Acknowledge(ctx, &pb.AcknowledgeRequest{Path: path, Id: "linecard0-parity"})
+	if err != nil || !resp.Status.Acknowledged {
+		t.Fatalf("Acknowledge: got %v, %v, want acknowledged status", resp, err)
+	}
+	for _, include := range []bool{false, true} {
+		list, err := s.List(ctx, &pb.ListRequest{Path: path, IncludeAcknowledged: include})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if want := map[bool]int{false: 0, true: 1}[include]; len(list.Statuses) != want {
+			t.Errorf("List(include_acknowledged=%v): got %d statuses, want %d", include, len(list.Statuses), want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	path := system.ComponentPath("fan0")
+	for _, want := range []struct {
+		id     string
+		status pb.Status
+	}{
+		{"fan0-check", pb.Status_STATUS_UNHEALTHY},
+		{"fan0-check-2", pb.Status_STATUS_HEALTHY},
+	} {
+		resp, err := s.Check(ctx, &pb.CheckRequest{Path: path})
+		if err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+		if resp.Status.Id != want.id || resp.Status.Status != want.status {
+			t.Errorf("Check: got %s %s, want %s %s", resp.Status.Id, resp.Status.Status, want.id, want.status)
+		}
+	}
+	list, _ := s.List(ctx, &pb.ListRequest{Path: path})
+	if len(list.Statuses) != 3 {
+		t.Errorf("List after checks: got %d statuses, want 3", len(list.Statuses))
+	}
+	if _, err := s.Check(ctx, &pb.CheckRequest{Path: path, EventId: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Check of unknown event: got %v, want NotFound", err)
+	}
+}
@@ -17,20 +17,30 @@ package os
 
 import (
 	"context"
-	"flag"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	log "github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
 	"github.com/google/gnxi/gnoi/os/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-var (
-	printProgess = flag.Bool("print_progress", false, "Prints progress periodically of file transfer.")
+const (
+	// DefaultRetries is how many times NewClient's clients retry failed installs.
+	DefaultRetries = 3
+	// DefaultRetryBackoff is how long NewClient's clients wait before the first
+	// retry, doubling on every retry up to maxRetryBackoff.
+	DefaultRetryBackoff = time.Second
+	maxRetryBackoff     = 30 * time.Second
 )
 
 var fileReader = func(path string) (file io.ReaderAt, size uint64, close func() error, err error) {
@@ -50,26 +60,252 @@ var fileReader = func(path string) (file io.ReaderAt, size uint64, close func()
 	return
 }
 
+// errStandbyUnavailable is returned by VerifyStandby while the standby
+// supervisor reboots.
+var errStandbyUnavailable = fmt.Errorf("Standby supervisor state: %s", pb.StandbyState_UNAVAILABLE)
+
+// errInstallInProgress is returned by installs refused because the target is
+// still receiving another transfer, possibly the one of a broken stream.
+var errInstallInProgress = fmt.Errorf("InstallError occurred: %s", pb.InstallError_INSTALL_IN_PROGRESS)
+
+// Progress is the progress of the transfer of an OS image.
+type Progress struct {
+	// Sent is the number of bytes of the image sent, Acknowledged the number of
+	// bytes the target acknowledged receiving and Total the size of the image.
+	Sent, Acknowledged, Total uint64
+	// Rate is the number of bytes sent per second since the transfer started or
+	// was resumed.
+	Rate float64
+	// ETA is the estimated time left to send the image, 0 if unknown.
+	ETA time.Duration
+	// Attempt is the number of the transfer attempt, starting at 1.
+	Attempt int
+	// Syncing is set while the package is synced from the other supervisor
+	// instead of transferred, Sent and Acknowledged then being the percentage
+	// synced out of a Total of 100.
+	Syncing bool
+}
+
+// Percent returns the percentage of the image acknowledged by the target.
+func (p Progress) Percent() float64 {
+	if p.Total == 0 {
+		return 100
+	}
+	return 100 * float64(p.Acknowledged) / float64(p.Total)
+}
+
+// ProgressFunc is called with the progress of a transfer every time a chunk is
+// sent or acknowledged.
+type ProgressFunc func(Progress)
+
+// PrintProgress prints the progress of a transfer.
+func PrintProgress(p Progress) {
+	if p.Syncing {
+		fmt.Printf("%d%% synced\n", p.Acknowledged)
+		return
+	}
+	line := fmt.Sprintf("%.1f%% transferred (%s of %s)", p.Percent(), humanize.Bytes(p.Acknowledged), humanize.Bytes(p.Total))
+	if p.Rate > 0 {
+		line += fmt.Sprintf(", %s/s, ETA %s", humanize.Bytes(uint64(p.Rate)), p.ETA.Round(time.Second))
+	}
+	if p.Attempt > 1 {
+		line += fmt.Sprintf(", attempt %d", p.Attempt)
+	}
+	fmt.Println(line)
+}
+
+// PrintAcknowledged returns a ProgressFunc printing the progress every time the
+// target acknowledges a chunk.
+func PrintAcknowledged() ProgressFunc {
+	var acknowledged uint64
+	return func(p Progress) {
+		if p.Acknowledged != acknowledged {
+			acknowledged = p.Acknowledged
+			PrintProgress(p)
+		}
+	}
+}
+
+// transferProgress tracks the progress of a transfer attempt.
+type transferProgress struct {
+	mu      sync.Mutex
+	f       ProgressFunc
+	p       Progress
+	started time.Time
+	resumed uint64
+}
+
+func newTransferProgress(f ProgressFunc, offset, total uint64, attempt int) *transferProgress {
+	return &transferProgress{
+		f:       f,
+		p:       Progress{Sent: offset, Acknowledged: offset, Total: total, Attempt: attempt},
+		started: now(),
+		resumed: offset,
+	}
+}
+
+// update reports that sent bytes were sent, or acknowledged bytes acknowledged
+// if acknowledged is set.
+func (t *transferProgress) update(n uint64, acknowledged bool) {
+	if t.f == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if acknowledged {
+		t.p.Acknowledged = n
+	} else {
+		t.p.Sent = n
+	}
+	if elapsed := now().Sub(t.started).Seconds(); elapsed > 0 && t.p.Sent > t.resumed {
+		t.p.Rate = float64(t.p.Sent-t.resumed) / elapsed
+		t.p.ETA = time.Duration(float64(t.p.Total-t.p.Sent) / t.p.Rate * float64(time.Second))
+	}
+	t.f(t.p)
+}
+
 // Client handles requesting OS RPCs.
 type Client struct {
-	client pb.OSClient
+	client       pb.OSClient
+	retries      int
+	retryBackoff time.Duration
+	timeout      time.Duration
 }
 
-// NewClient returns a new OS service client.
+// NewClient returns a new OS service client, retrying failed installs
+// DefaultRetries times.
 func NewClient(c *grpc.ClientConn) *Client {
-	return &Client{client: pb.NewOSClient(c)}
+	return &Client{client: pb.NewOSClient(c), retries: DefaultRetries, retryBackoff: DefaultRetryBackoff}
+}
+
+// SetRetry sets how many times installs failing with a transient error are
+// retried, resuming the transfer, and how long to wait before the first retry.
+// The wait doubles on every retry.
+func (c *Client) SetRetry(retries int, backoff time.Duration) {
+	c.retries = retries
+	c.retryBackoff = backoff
+}
+
+// SetTimeout bounds every Activate and Verify RPC by timeout, and cancels
+// install attempts making no progress for timeout, so that one hanging RPC
+// doesn't use up the context of a whole upgrade. RPCs are only bounded by their
+// context if timeout is 0, the default.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// rpcContext returns the context of an RPC, bounded by the timeout set by
+// SetTimeout.
+func (c *Client) rpcContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// idleTimer cancels an install stream once it isn't reset for timeout. It never
+// fires if timeout is 0.
+type idleTimer struct {
+	mu      sync.Mutex
+	timer   *time.Timer
+	timeout time.Duration
+	stopped bool
+	fired   bool
+}
+
+func newIdleTimer(timeout time.Duration, cancel func()) *idleTimer {
+	t := &idleTimer{timeout: timeout}
+	if timeout > 0 {
+		t.timer = time.AfterFunc(timeout, func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if !t.stopped {
+				t.fired = true
+				cancel()
+			}
+		})
+	}
+	return t
 }
 
-// Install invokes the Install RPC for the OS service.
-func (c *Client) Install(ctx context.Context, imgPath, version string, validateTimeout time.Duration, chunkSize uint64) error {
+// reset restarts the timer after the stream made progress.
+func (t *idleTimer) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.timer != nil && !t.stopped && !t.fired {
+		t.timer.Reset(t.timeout)
+	}
+}
+
+// stop stops the timer for good, returning true if it fired.
+func (t *idleTimer) stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.stopped = true
+	return t.fired
+}
+
+// transient returns true for errors of a broken stream or an unavailable target.
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// Install invokes the Install RPC for the OS service, installing on the standby
+// supervisor if standby is set. progress is called with the progress of the
+// transfer, or of the sync from the other supervisor, if not nil. Transient failures are retried as set by SetRetry.
+func (c *Client) Install(ctx context.Context, imgPath, version string, standby bool, validateTimeout time.Duration, chunkSize uint64, progress ProgressFunc) error {
 	file, fileSize, fileClose, err := fileReader(imgPath)
 	if err != nil {
 		return err
 	}
 	defer fileClose()
 
+	// The content hash lets the target resume an interrupted transfer.
+	h := sha256.New()
+	if _, err = io.Copy(h, io.NewSectionReader(file, 0, int64(fileSize))); err != nil {
+		return err
+	}
+	contentHash := h.Sum(nil)
+
+	backoff := c.retryBackoff
+	for attempt := 1; ; attempt++ {
+		err = c.install(ctx, file, fileSize, contentHash, version, standby, validateTimeout, chunkSize, progress, attempt)
+		// The target may still be receiving the transfer of a broken stream.
+		inProgress := attempt > 1 && err == errInstallInProgress
+		if err == nil || attempt > c.retries || !transient(err) && !inProgress {
+			return err
+		}
+		log.Warningf("Install attempt %d failed, retrying in %s: %v", attempt, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+		if backoff *= 2; backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// install makes an attempt to install the OS image in file. It fails with
+// Unavailable if the transfer makes no progress for the timeout set by
+// SetTimeout.
+func (c *Client) install(ctx context.Context, file io.ReaderAt, fileSize uint64, contentHash []byte, version string, standby bool, validateTimeout time.Duration, chunkSize uint64, progress ProgressFunc, attempt int) (err error) {
 	cancelCtx, cancelStream := context.WithCancel(ctx)
 	defer cancelStream()
+	idle := newIdleTimer(c.timeout, cancelStream)
+	defer func() {
+		if idle.stop() && err != nil {
+			err = status.Errorf(codes.Unavailable, "install made no progress for %s: %v", c.timeout, err)
+		}
+	}()
 
 	install, err := c.client.Install(cancelCtx)
 	if err != nil {
@@ -78,7 +314,7 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 
 	// Send initial TransferRequest and await response.
 	request := &pb.InstallRequest{
-		Request: &pb.InstallRequest_TransferRequest{TransferRequest: &pb.TransferRequest{Version: version}},
+		Request: &pb.InstallRequest_TransferRequest{TransferRequest: &pb.TransferRequest{Version: version, StandbySupervisor: standby, ContentHash: contentHash}},
 	}
 	log.V(1).Info("InstallRequest:\n", proto.MarshalTextString(request))
 	if err = install.Send(request); err != nil {
@@ -89,9 +325,28 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 	if transferResp, err = install.Recv(); err != nil {
 		return err
 	}
+	idle.reset()
 	log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(transferResp))
+	// The package is synced from the other supervisor if it has it.
+	synced := false
+	for syncProgress := transferResp.GetSyncProgress(); syncProgress != nil; syncProgress = transferResp.GetSyncProgress() {
+		synced = true
+		if progress != nil {
+			percentage := uint64(syncProgress.GetPercentageTransferred())
+			progress(Progress{Sent: percentage, Acknowledged: percentage, Total: 100, Attempt: attempt, Syncing: true})
+		}
+		if transferResp, err = install.Recv(); err != nil {
+			return err
+		}
+		idle.reset()
+		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(transferResp))
+	}
 	switch resp := transferResp.Response.(type) {
 	case *pb.InstallResponse_Validated:
+		if synced {
+			log.Infof("OS version %s synced from the other supervisor", version)
+			return nil
+		}
 		log.Infof("OS version %s is already installed", version)
 		return nil
 	case *pb.InstallResponse_InstallError:
@@ -99,11 +354,22 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 		if installErr.GetType() == pb.InstallError_UNSPECIFIED {
 			return fmt.Errorf("Unspecified InstallError error: %s", installErr.GetDetail())
 		}
+		if installErr.GetType() == pb.InstallError_INSTALL_IN_PROGRESS {
+			return errInstallInProgress
+		}
 		return fmt.Errorf("InstallError occurred: %s", installErr.GetType().String())
 	case *pb.InstallResponse_TransferReady:
 	default:
 		return fmt.Errorf("Unexpected response: %T(%v)", resp, resp)
 	}
+	offset := transferResp.GetTransferReady().GetResumeOffset()
+	if offset > fileSize {
+		return fmt.Errorf("Resume offset %d is past the end of the %d bytes image", offset, fileSize)
+	}
+	if offset > 0 {
+		log.Infof("Resuming transfer at %d of %d bytes", offset, fileSize)
+	}
+	tracker := newTransferProgress(progress, offset, fileSize, attempt)
 
 	errs := make(chan error, 2)
 	validated := make(chan bool, 1)
@@ -118,11 +384,10 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 				errs <- err
 				return
 			}
+			idle.reset()
 			switch resp := response.Response.(type) {
 			case *pb.InstallResponse_TransferProgress:
-				if *printProgess {
-					fmt.Printf("%d%% transferred\n", resp.TransferProgress.GetBytesReceived()/fileSize)
-				}
+				tracker.update(resp.TransferProgress.GetBytesReceived(), true)
 			case *pb.InstallResponse_Validated:
 				log.V(1).Info("InstallResponse_Validated:\n", proto.MarshalTextString(response))
 				validated <- true
@@ -150,10 +415,10 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 	// Goroutine to read from file in chunks, sending a chunk of the
 	// image each time.
 	go func() {
-		var readSize int
 		b := make([]byte, chunkSize)
-		for n := int64(0); n < int64(fileSize)+int64(chunkSize); n += int64(chunkSize) {
-			if readSize, err = file.ReadAt(b, n); err != nil && err != io.EOF {
+		for n := int64(offset); n < int64(fileSize)+int64(chunkSize); n += int64(chunkSize) {
+			readSize, err := file.ReadAt(b, n)
+			if err != nil && err != io.EOF {
 				errs <- err
 				return
 			}
@@ -163,9 +428,14 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 			if err = install.Send(&pb.InstallRequest{
 				Request: &pb.InstallRequest_TransferContent{TransferContent: b[:readSize]},
 			}); err != nil {
-				errs <- err
+				// The receiver gets the status of a broken stream.
+				if err != io.EOF {
+					errs <- err
+				}
 				return
 			}
+			idle.reset()
+			tracker.update(uint64(n)+uint64(readSize), false)
 		}
 		doneSend <- true
 	}()
@@ -178,6 +448,8 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 		if err = install.Send(request); err != nil {
 			return err
 		}
+		// The validation is bounded by validateTimeout instead.
+		idle.stop()
 	case err := <-errs:
 		return err
 	}
@@ -192,10 +464,14 @@ func (c *Client) Install(ctx context.Context, imgPath, version string, validateT
 	return nil
 }
 
-// Activate invokes the Activate RPC for the OS service.
-func (c *Client) Activate(ctx context.Context, version string) error {
-	request := &pb.ActivateRequest{Version: version}
+// Activate invokes the Activate RPC for the OS service. The target reboots into
+// the activated version unless noReboot is set. If standby is set the version is
+// activated on the standby supervisor.
+func (c *Client) Activate(ctx context.Context, version string, noReboot, standby bool) error {
+	request := &pb.ActivateRequest{Version: version, NoReboot: noReboot, StandbySupervisor: standby}
 	log.V(1).Info("ActivateRequest:\n", proto.MarshalTextString(request))
+	ctx, cancel := c.rpcContext(ctx)
+	defer cancel()
 	response, err := c.client.Activate(ctx, request)
 	if err != nil {
 		return err
@@ -223,6 +499,8 @@ func (c *Client) Verify(ctx context.Context) (version, activationFailMsg string,
 	request := &pb.VerifyRequest{}
 	log.V(1).Info("VerifyRequest:\n", proto.MarshalTextString(request))
 	var out *pb.VerifyResponse
+	ctx, cancel := c.rpcContext(ctx)
+	defer cancel()
 	if out, err = c.client.Verify(ctx, request); err != nil {
 		return
 	}
@@ -231,3 +509,85 @@ func (c *Client) Verify(ctx context.Context) (version, activationFailMsg string,
 	activationFailMsg = out.GetActivationFailMessage()
 	return
 }
+
+// VerifyStandby invokes the Verify RPC for the OS service, returning the version
+// running on the standby supervisor and the fail message of its last activation.
+// It fails if the target has no available standby supervisor.
+func (c *Client) VerifyStandby(ctx context.Context) (id, version, activationFailMsg string, err error) {
+	request := &pb.VerifyRequest{}
+	log.V(1).Info("VerifyRequest:\n", proto.MarshalTextString(request))
+	var out *pb.VerifyResponse
+	ctx, cancel := c.rpcContext(ctx)
+	defer cancel()
+	if out, err = c.client.Verify(ctx, request); err != nil {
+		return
+	}
+	log.V(1).Info("VerifyResponse:\n", proto.MarshalTextString(out))
+	switch state := out.GetVerifyStandby().GetState().(type) {
+	case *pb.VerifyStandby_VerifyResponse:
+		standby := state.VerifyResponse
+		return standby.GetId(), standby.GetVersion(), standby.GetActivationFailMessage(), nil
+	case *pb.VerifyStandby_StandbyState:
+		if state.StandbyState.GetState() == pb.StandbyState_UNAVAILABLE {
+			err = errStandbyUnavailable
+			break
+		}
+		err = fmt.Errorf("Standby supervisor state: %s", state.StandbyState.GetState())
+	default:
+		err = fmt.Errorf("Standby supervisor state: %s", pb.StandbyState_UNSUPORTED)
+	}
+	return
+}
+
+// running returns the version running on the supervisor and the fail message
+// of its last activation.
+func (c *Client) running(ctx context.Context, standby bool) (version, activationFailMsg string, err error) {
+	if standby {
+		_, version, activationFailMsg, err = c.VerifyStandby(ctx)
+		return
+	}
+	return c.Verify(ctx)
+}
+
+// Upgrade installs the OS image, activates it and polls the target every
+// pollInterval until it runs the new version after rebooting. It fails if the
+// activation fails or ctx expires first. Each RPC is also bounded by the
+// timeout set by SetTimeout.
+func (c *Client) Upgrade(ctx context.Context, imgPath, version string, standby bool, validateTimeout time.Duration, chunkSize uint64, progress ProgressFunc, pollInterval time.Duration) error {
+	if err := c.Install(ctx, imgPath, version, standby, validateTimeout, chunkSize, progress); err != nil {
+		return fmt.Errorf("install failed: %w", err)
+	}
+	if err := c.Activate(ctx, version, false, standby); err != nil {
+		return fmt.Errorf("activate failed: %w", err)
+	}
+	return c.awaitVersion(ctx, version, standby, pollInterval)
+}
+
+// awaitVersion polls the target every pollInterval until the supervisor runs
+// version. Targets clear the fail message of the previous activation when
+// activating, so the upgrade fails as soon as the supervisor reports a fail
+// message while running another version.
+func (c *Client) awaitVersion(ctx context.Context, version string, standby bool, pollInterval time.Duration) error {
+	rebooting := false
+	for {
+		running, failMsg, err := c.running(ctx, standby)
+		switch {
+		case err == nil && running == version:
+			return nil
+		case err == nil && failMsg != "":
+			return fmt.Errorf("activation of %s failed, running %s: %s", version, running, failMsg)
+		case status.Code(err) == codes.Unavailable || errors.Is(err, errStandbyUnavailable):
+			if !rebooting {
+				log.Info("Waiting for the target to reboot")
+			}
+			rebooting = true
+		case err != nil && ctx.Err() == nil:
+			log.Warningf("Verify failed: %v", err)
+		}
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for version %s: %w", version, ctx.Err())
+		}
+	}
+}
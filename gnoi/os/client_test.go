@@ -22,12 +22,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/gnxi/gnoi/os/pb"
+	"github.com/google/gnxi/utils/mockos"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const readChunkSize = 4000000 // 4MB. Highest amount of data a chunk should be since >5MB will cause a grpc transport error.
@@ -40,6 +48,8 @@ type mockClient struct {
 	activate      activateRPC
 	verify        verifyRPC
 	installClient pb.OS_InstallClient
+	// installs are returned by consecutive Install calls instead of installClient.
+	installs []pb.OS_InstallClient
 }
 
 type installRequestMap struct {
@@ -100,9 +110,78 @@ func (c *mockClient) Verify(ctx context.Context, in *pb.VerifyRequest, opts ...g
 }
 
 func (c *mockClient) Install(ctx context.Context, opts ...grpc.CallOption) (pb.OS_InstallClient, error) {
+	if len(c.installs) > 0 {
+		install := c.installs[0]
+		c.installs = c.installs[1:]
+		return install, nil
+	}
 	return c.installClient, nil
 }
 
+// transferInstallClient acknowledges every chunk of a transfer resumed at
+// offset, breaking the stream with err after failAfter chunks if err is set.
+type transferInstallClient struct {
+	pb.OS_InstallClient
+	offset    uint64
+	failAfter int
+	err       error
+	chunks    int
+	received  uint64
+	broken    bool
+	resps     chan *pb.InstallResponse
+}
+
+func newTransferInstallClient(offset uint64, failAfter int, err error) *transferInstallClient {
+	return &transferInstallClient{offset: offset, failAfter: failAfter, err: err, resps: make(chan *pb.InstallResponse, 16)}
+}
+
+func (c *transferInstallClient) Send(req *pb.InstallRequest) error {
+	if c.broken {
+		return io.EOF
+	}
+	switch r := req.Request.(type) {
+	case *pb.InstallRequest_TransferRequest:
+		c.received = c.offset
+		c.resps <- &pb.InstallResponse{Response: &pb.InstallResponse_TransferReady{TransferReady: &pb.TransferReady{ResumeOffset: c.offset}}}
+	case *pb.InstallRequest_TransferContent:
+		if c.err != nil && c.chunks == c.failAfter {
+			c.broken = true
+			close(c.resps)
+			return io.EOF
+		}
+		c.chunks++
+		c.received += uint64(len(r.TransferContent))
+		c.resps <- &pb.InstallResponse{Response: &pb.InstallResponse_TransferProgress{TransferProgress: &pb.TransferProgress{BytesReceived: c.received}}}
+	case *pb.InstallRequest_TransferEnd:
+		c.resps <- &pb.InstallResponse{Response: &pb.InstallResponse_Validated{Validated: &pb.Validated{Version: "version"}}}
+	}
+	return nil
+}
+
+func (c *transferInstallClient) Recv() (*pb.InstallResponse, error) {
+	resp, ok := <-c.resps
+	if !ok {
+		return nil, c.err
+	}
+	return resp, nil
+}
+
+func (c *transferInstallClient) CloseSend() error {
+	return nil
+}
+
+// newInProgressInstallClient refuses the transfer as another one is in progress.
+func newInProgressInstallClient() *mockInstallClient {
+	return &mockInstallClient{
+		reqMap: []*installRequestMap{{
+			req:  &pb.InstallRequest{Request: &pb.InstallRequest_TransferRequest{}},
+			resp: &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INSTALL_IN_PROGRESS}}},
+		}},
+		recv:    make(chan int, 1),
+		recvErr: make(chan *pb.InstallResponse_InstallError, 1),
+	}
+}
+
 func activateErrorRPC(errType pb.ActivateError_Type, detail string) activateRPC {
 	return func(ctx context.Context, in *pb.ActivateRequest, opts ...grpc.CallOption) (*pb.ActivateResponse, error) {
 		return &pb.ActivateResponse{
@@ -165,6 +244,38 @@ func TestInstall(t *testing.T) {
 			nil,
 			100 * time.Millisecond,
 		},
+		{
+			"Resumed after one chunk then validated",
+			[]*installRequestMap{
+				{
+					&pb.InstallRequest{Request: &pb.InstallRequest_TransferRequest{TransferRequest: &pb.TransferRequest{Version: "version"}}},
+					&pb.InstallResponse{Response: &pb.InstallResponse_TransferReady{TransferReady: &pb.TransferReady{ResumeOffset: readChunkSize}}},
+				},
+				{
+					&pb.InstallRequest{Request: &pb.InstallRequest_TransferContent{}},
+					&pb.InstallResponse{Response: &pb.InstallResponse_TransferProgress{TransferProgress: &pb.TransferProgress{BytesReceived: 2 * readChunkSize}}},
+				},
+				{
+					&pb.InstallRequest{Request: &pb.InstallRequest_TransferEnd{}},
+					&pb.InstallResponse{Response: &pb.InstallResponse_Validated{Validated: &pb.Validated{}}},
+				},
+			},
+			readBytes(2 * int(readChunkSize)),
+			nil,
+			100 * time.Millisecond,
+		},
+		{
+			"Resume offset past the end of the file",
+			[]*installRequestMap{
+				{
+					&pb.InstallRequest{Request: &pb.InstallRequest_TransferRequest{TransferRequest: &pb.TransferRequest{Version: "version"}}},
+					&pb.InstallResponse{Response: &pb.InstallResponse_TransferReady{TransferReady: &pb.TransferReady{ResumeOffset: readChunkSize + 1}}},
+				},
+			},
+			readBytes(int(readChunkSize)),
+			fmt.Errorf("Resume offset %d is past the end of the %d bytes image", readChunkSize+1, readChunkSize),
+			100 * time.Millisecond,
+		},
 		{
 			"File size of two chunks + 1 then validated",
 			[]*installRequestMap{
@@ -280,13 +391,310 @@ func TestInstall(t *testing.T) {
 				}},
 			}
 			fileReader = test.reader
-			if err := client.Install(context.Background(), "", "version", test.timeout, readChunkSize); fmt.Sprintf("%v", err) != fmt.Sprintf("%v", test.err) {
+			if err := client.Install(context.Background(), "", "version", false, test.timeout, readChunkSize, nil); fmt.Sprintf("%v", err) != fmt.Sprintf("%v", test.err) {
 				t.Errorf("Wanted error: **%v** but got error: **%v**", test.err, err)
 			}
 		})
 	}
 }
 
+func TestInstallProgress(t *testing.T) {
+	fileReader = readBytes(30)
+	var got []Progress
+	client := Client{client: &mockClient{installClient: newTransferInstallClient(0, 0, nil)}}
+	if err := client.Install(context.Background(), "", "version", false, time.Second, 10, func(p Progress) {
+		got = append(got, p)
+	}); err != nil {
+		t.Fatalf("Install failed: %v", err)
+	}
+	if len(got) != 6 {
+		t.Fatalf("got %d progress reports, want one per chunk sent and acknowledged: %v", len(got), got)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Sent < got[i-1].Sent || got[i].Acknowledged < got[i-1].Acknowledged {
+			t.Errorf("progress went backwards: %v then %v", got[i-1], got[i])
+		}
+	}
+	last := got[len(got)-1]
+	if last.Sent != 30 || last.Acknowledged != 30 || last.Total != 30 || last.Percent() != 100 || last.Attempt != 1 {
+		t.Errorf("got final progress %+v, want all 30 bytes sent and acknowledged in attempt 1", last)
+	}
+}
+
+func TestInstallRetry(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection reset")
+	tests := []struct {
+		name        string
+		retries     int
+		installs    []pb.OS_InstallClient
+		wantErr     error
+		wantAttempt int
+	}{
+		{
+			"Resumed after a broken stream",
+			1,
+			[]pb.OS_InstallClient{newTransferInstallClient(0, 1, unavailable), newTransferInstallClient(10, 0, nil)},
+			nil,
+			2,
+		},
+		{
+			"Out of retries",
+			1,
+			[]pb.OS_InstallClient{newTransferInstallClient(0, 1, unavailable), newTransferInstallClient(10, 1, unavailable)},
+			unavailable,
+			2,
+		},
+		{
+			"Resumed after the target released the broken transfer",
+			2,
+			[]pb.OS_InstallClient{newTransferInstallClient(0, 1, unavailable), newInProgressInstallClient(), newTransferInstallClient(10, 0, nil)},
+			nil,
+			3,
+		},
+		{
+			"Permanent error not retried",
+			1,
+			[]pb.OS_InstallClient{newTransferInstallClient(0, 1, status.Error(codes.PermissionDenied, "denied"))},
+			status.Error(codes.PermissionDenied, "denied"),
+			1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fileReader = readBytes(30)
+			client := Client{client: &mockClient{installs: test.installs}}
+			client.SetRetry(test.retries, time.Millisecond)
+			var attempt int
+			err := client.Install(context.Background(), "", "version", false, time.Second, 10, func(p Progress) {
+				attempt = p.Attempt
+			})
+			if fmt.Sprintf("%v", err) != fmt.Sprintf("%v", test.wantErr) {
+				t.Errorf("Install: got error %v, want %v", err, test.wantErr)
+			}
+			if attempt != test.wantAttempt {
+				t.Errorf("Install: got %d attempts, want %d", attempt, test.wantAttempt)
+			}
+		})
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	tests := []struct {
+		name    string
+		verify  []*pb.VerifyResponse
+		wantErr string
+	}{
+		{
+			"Rebooted into new version",
+			[]*pb.VerifyResponse{{Version: "old"}, nil, nil, {Version: "version"}},
+			"",
+		},
+		{
+			"Activation failed after reboot",
+			[]*pb.VerifyResponse{{Version: "old"}, nil, {Version: "old", ActivationFailMessage: "kernel panic"}},
+			"kernel panic",
+		},
+		{
+			"Activation failed without becoming unavailable",
+			[]*pb.VerifyResponse{{Version: "old", ActivationFailMessage: "kernel panic"}},
+			"kernel panic",
+		},
+		{
+			"Never rebooted",
+			[]*pb.VerifyResponse{{Version: "old"}},
+			"timed out",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fileReader = readBytes(30)
+			i := 0
+			client := Client{client: &mockClient{
+				installClient: newTransferInstallClient(0, 0, nil),
+				activate:      activateSuccessRPC,
+				verify: func(ctx context.Context, in *pb.VerifyRequest, opts ...grpc.CallOption) (*pb.VerifyResponse, error) {
+					resp := test.verify[i]
+					if i < len(test.verify)-1 {
+						i++
+					}
+					if resp == nil {
+						return nil, unavailable
+					}
+					return resp, nil
+				},
+			}}
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			err := client.Upgrade(ctx, "", "version", false, time.Second, 10, nil, time.Millisecond)
+			if (err == nil) != (test.wantErr == "") || err != nil && !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("Upgrade: got error %v, want error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+// newTestClient returns a client of a server with settings, and a function
+// stopping both.
+func newTestClient(t *testing.T, settings *Settings) (*Client, func()) {
+	t.Helper()
+	g := grpc.NewServer()
+	NewServer(settings).Register(g)
+	listen, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal("server failed to listen:", err)
+	}
+	go g.Serve(listen)
+	conn, err := grpc.Dial(listen.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal("failed to dial:", err)
+	}
+	return NewClient(conn), func() {
+		conn.Close()
+		g.Stop()
+	}
+}
+
+func TestInstallSyncProgress(t *testing.T) {
+	fileReader = readBytes(30)
+	client, stop := newTestClient(t, &Settings{
+		FactoryVersion:           "1.0.0a",
+		DualSupervisor:           true,
+		StandbyID:                "RP1",
+		StandbyInstalledVersions: []string{"1.0.1a"},
+	})
+	defer stop()
+	var got []uint64
+	if err := client.Install(context.Background(), "", "1.0.1a", false, time.Second, 10, func(p Progress) {
+		if !p.Syncing || p.Total != 100 {
+			t.Errorf("got progress %+v, want sync progress", p)
+		}
+		got = append(got, p.Acknowledged)
+	}); err != nil {
+		t.Fatalf("Install failed: %v", err)
+	}
+	if want := []uint64{0, 25, 50, 75, 100}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got sync progress %v, want %v", got, want)
+	}
+}
+
+// slowReader delays every read by delay, and the read at stallOffset by stall.
+type slowReader struct {
+	io.ReaderAt
+	delay, stall time.Duration
+	stallOffset  int64
+}
+
+func (r *slowReader) ReadAt(p []byte, off int64) (int, error) {
+	time.Sleep(r.delay)
+	if off == r.stallOffset {
+		time.Sleep(r.stall)
+	}
+	return r.ReaderAt.ReadAt(p, off)
+}
+
+func TestInstallTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "os-install")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	image := filepath.Join(dir, "os.img")
+	if err := mockos.GenerateOS(image, "1.0.1a", "2KB", "", false, nil); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		reader   *slowReader
+		wantCode codes.Code
+	}{
+		{"Transfer longer than the timeout", &slowReader{ReaderAt: bytes.NewReader(b), delay: 10 * time.Millisecond, stallOffset: -1}, codes.OK},
+		{"Stalled transfer", &slowReader{ReaderAt: bytes.NewReader(b), stall: 200 * time.Millisecond, stallOffset: 256}, codes.Unavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transferDir, err := ioutil.TempDir(dir, "transfers")
+			if err != nil {
+				t.Fatal(err)
+			}
+			client, stop := newTestClient(t, &Settings{FactoryVersion: "1.0.0a", TransferDir: transferDir})
+			defer stop()
+			client.SetTimeout(50 * time.Millisecond)
+			client.SetRetry(0, 0)
+			fileReader = func(string) (io.ReaderAt, uint64, func() error, error) {
+				return test.reader, uint64(len(b)), func() error { return nil }, nil
+			}
+			// The transfer of 256 bytes chunks takes longer than the timeout.
+			err = client.Install(context.Background(), image, "1.0.1a", false, time.Second, 256, nil)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("Install: got error %v, want code %s", err, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestUpgradeRepeatedFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "os-upgrade")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	image := filepath.Join(dir, "bad.img")
+	if err := mockos.GenerateOS(image, "1.0.1a", "1KB", "kernel panic", false, nil); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileReader = func(string) (io.ReaderAt, uint64, func() error, error) {
+		return bytes.NewReader(b), uint64(len(b)), func() error { return nil }, nil
+	}
+	client, stop := newTestClient(t, &Settings{FactoryVersion: "1.0.0a", TransferDir: dir})
+	defer stop()
+
+	// The second upgrade fails with the same message as the first one.
+	for i := 1; i <= 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := client.Upgrade(ctx, image, "1.0.1a", false, time.Second, readChunkSize, nil, time.Millisecond)
+		cancel()
+		if err == nil || !strings.Contains(err.Error(), "kernel panic") {
+			t.Errorf("Upgrade %d: got error %v, want activation failure", i, err)
+		}
+	}
+}
+
+func TestUpgradeTimeout(t *testing.T) {
+	fileReader = readBytes(30)
+	polls := 0
+	client := Client{client: &mockClient{
+		installClient: newTransferInstallClient(0, 0, nil),
+		activate:      activateSuccessRPC,
+		verify: func(ctx context.Context, in *pb.VerifyRequest, opts ...grpc.CallOption) (*pb.VerifyResponse, error) {
+			polls++
+			switch polls {
+			case 1:
+				return &pb.VerifyResponse{Version: "old"}, nil
+			case 2:
+				// The connection hangs while the target reboots.
+				<-ctx.Done()
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+			return &pb.VerifyResponse{Version: "version"}, nil
+		},
+	}}
+	client.SetTimeout(10 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := client.Upgrade(ctx, "", "version", false, time.Second, 10, nil, time.Millisecond); err != nil {
+		t.Errorf("Upgrade with a hanging Verify failed: %v", err)
+	}
+}
+
 func TestActivate(t *testing.T) {
 	activateTests := []struct {
 		name    string
@@ -312,7 +720,7 @@ func TestActivate(t *testing.T) {
 	for _, test := range activateTests {
 		t.Run(test.name, func(t *testing.T) {
 			client := Client{client: test.client}
-			got := client.Activate(context.Background(), "version")
+			got := client.Activate(context.Background(), "version", false, false)
 			if test.wantErr {
 				if got == nil {
 					t.Error("want error, got nil")
@@ -356,3 +764,29 @@ func TestVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyStandby(t *testing.T) {
+	tests := []struct {
+		name        string
+		standby     *pb.VerifyStandby
+		wantVersion string
+		wantErr     bool
+	}{
+		{"Single supervisor", nil, "", true},
+		{"Unavailable", &pb.VerifyStandby{State: &pb.VerifyStandby_StandbyState{StandbyState: &pb.StandbyState{State: pb.StandbyState_UNAVAILABLE}}}, "", true},
+		{"Running", &pb.VerifyStandby{State: &pb.VerifyStandby_VerifyResponse{VerifyResponse: &pb.StandbyResponse{Id: "RP1", Version: "version"}}}, "version", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := Client{client: &mockClient{
+				verify: func(ctx context.Context, in *pb.VerifyRequest, opts ...grpc.CallOption) (*pb.VerifyResponse, error) {
+					return &pb.VerifyResponse{Version: "primary", VerifyStandby: test.standby}, nil
+				},
+			}}
+			_, version, _, err := client.VerifyStandby(context.Background())
+			if (err != nil) != test.wantErr || version != test.wantVersion {
+				t.Errorf("VerifyStandby: got (%q, %v), want version %q and error %v", version, err, test.wantVersion, test.wantErr)
+			}
+		})
+	}
+}
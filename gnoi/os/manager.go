@@ -13,18 +13,41 @@ limitations under the License.
 package os
 
 import (
+	"context"
+	"crypto/x509"
 	"fmt"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Manager for storing data on OS's.
+var now = time.Now
+
+// Manager for storing data on OS's. It simulates the reboots activating OS
+// versions: the target is unavailable while it boots, after which the activated
+// version runs, or the previous version if the activation fails.
 type Manager struct {
 	osMap                 map[string]bool
 	failMsgs              map[string]string
 	runningVersion        string
+	activeVersion         string
 	factoryVersion        string
 	activationFailMessage string
-	mu                    sync.RWMutex
+	rebootDelay           time.Duration
+	bootTime              time.Duration
+	// rebootAt is when the pending reboot starts, zero if none is pending.
+	rebootAt time.Time
+	// bootedAt is when the ongoing boot ends, zero if the target isn't booting.
+	bootedAt time.Time
+	// dualSupervisor is set on dual supervisor targets, whose standby
+	// supervisor is managed by standby, nil if the standby is missing.
+	dualSupervisor bool
+	standby        *Manager
+	standbyID      string
+	mu             sync.RWMutex
 }
 
 // Settings wraps OS Server initialization options.
@@ -32,6 +55,27 @@ type Settings struct {
 	FactoryVersion      string
 	InstalledVersions   []string
 	ReceiveChunkSizeAck uint64
+	// TransferDir is the directory OS packages are received into, the default
+	// temporary directory if empty. Partial transfers left in it are resumable.
+	TransferDir string
+	// MaxConcurrentTransfers is the number of OS packages received at the same
+	// time, 1 if unset. Each transfer holds a single chunk in memory.
+	MaxConcurrentTransfers int
+	// TrustBundle are the CA certificates OS packages must be signed by. Packages
+	// don't need to be signed if empty.
+	TrustBundle []*x509.Certificate
+	// RebootDelay is the time between an activation and the reboot it triggers.
+	RebootDelay time.Duration
+	// BootTime is how long the target is unavailable while it reboots.
+	BootTime time.Duration
+	// DualSupervisor makes the target a dual supervisor target.
+	DualSupervisor bool
+	// StandbyID identifies the standby supervisor of a dual supervisor target,
+	// usually by its slot. The standby supervisor is missing if empty.
+	StandbyID string
+	// StandbyInstalledVersions are the OS versions installed on the standby
+	// supervisor besides the factory version.
+	StandbyInstalledVersions []string
 }
 
 // NewManager for OS service module. Will manage state of OS module.
@@ -43,10 +87,44 @@ func NewManager(factoryVersion string) *Manager {
 	}
 }
 
+// SetTimings sets the delay of the reboots triggered by activations and how
+// long the target takes to boot. Both are zero by default, rebooting instantly.
+func (m *Manager) SetTimings(rebootDelay, bootTime time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rebootDelay = rebootDelay
+	m.bootTime = bootTime
+}
+
+// advance moves the simulated reboot forward to the current time. It must be
+// called with the lock held.
+func (m *Manager) advance() {
+	t := now()
+	if !m.rebootAt.IsZero() && !t.Before(m.rebootAt) {
+		m.bootedAt = m.rebootAt.Add(m.bootTime)
+		m.rebootAt = time.Time{}
+	}
+	if !m.bootedAt.IsZero() && !t.Before(m.bootedAt) {
+		m.bootedAt = time.Time{}
+		m.boot()
+	}
+}
+
+// boot boots the active OS version. If it fails to activate, the previously
+// running OS keeps running and becomes active again.
+func (m *Manager) boot() {
+	if m.activationFailMessage = m.failMsgs[m.activeVersion]; m.activationFailMessage != "" {
+		m.activeVersion = m.runningVersion
+		return
+	}
+	m.runningVersion = m.activeVersion
+}
+
 // IsRunning will tell us whether or not the OS version specified is currently running.
 func (m *Manager) IsRunning(version string) bool {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.advance()
 	return version == m.runningVersion
 }
 
@@ -59,11 +137,98 @@ func (m *Manager) SetRunning(version string) error {
 	}
 	if _, ok := m.osMap[version]; ok {
 		m.runningVersion = version
+		m.activeVersion = version
 		return nil
 	}
 	return fmt.Errorf("NON_EXISTENT_VERSION")
 }
 
+// Activate sets the OS version to boot and, unless noReboot is set, reboots
+// the target after the reboot delay. Activating the running version doesn't
+// reboot the target. The fail message of the last activation is cleared unless
+// noReboot is set, so that a fail message reported afterwards always comes from
+// this activation.
+func (m *Manager) Activate(version string, noReboot bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.advance()
+	if !m.osMap[version] {
+		return fmt.Errorf("NON_EXISTENT_VERSION")
+	}
+	m.activeVersion = version
+	if !noReboot {
+		m.activationFailMessage = ""
+	}
+	if noReboot || version == m.runningVersion || !m.rebootAt.IsZero() || !m.bootedAt.IsZero() {
+		return nil
+	}
+	m.rebootAt = now().Add(m.rebootDelay)
+	m.advance()
+	return nil
+}
+
+// Reboot reboots the target into the active OS version immediately, unless it
+// is already booting.
+func (m *Manager) Reboot() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.advance()
+	if !m.bootedAt.IsZero() {
+		return
+	}
+	m.rebootAt = now()
+	m.advance()
+}
+
+// FactoryReset rolls the OS back to the factory version, uninstalling every
+// other version and cancelling pending reboots.
+func (m *Manager) FactoryReset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.osMap = map[string]bool{m.factoryVersion: true}
+	m.failMsgs = map[string]string{}
+	m.runningVersion = m.factoryVersion
+	m.activeVersion = m.factoryVersion
+	m.activationFailMessage = ""
+	m.rebootAt = time.Time{}
+	m.bootedAt = time.Time{}
+}
+
+// Rebooting returns true while the target is booting.
+func (m *Manager) Rebooting() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.advance()
+	return !m.bootedAt.IsZero()
+}
+
+// Running returns the OS version currently running and the fail message of the
+// last failed activation, if any.
+func (m *Manager) Running() (version, activationFailMsg string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.advance()
+	return m.runningVersion, m.activationFailMessage
+}
+
+// UnaryInterceptor fails unary RPCs with Unavailable while the target is
+// booting.
+func (m *Manager) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if m.Rebooting() {
+		return nil, status.Errorf(codes.Unavailable, "target is rebooting")
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor fails streaming RPCs with Unavailable while the target is
+// booting.
+func (m *Manager) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if m.Rebooting() {
+		return status.Errorf(codes.Unavailable, "target is rebooting")
+	}
+	return handler(srv, ss)
+}
+
 // Install installs an OS. It must be fully transferred and verified beforehand.
 func (m *Manager) Install(version, activationFailMsg string) {
 	m.mu.Lock()
@@ -72,6 +237,33 @@ func (m *Manager) Install(version, activationFailMsg string) {
 	m.failMsgs[version] = activationFailMsg
 }
 
+// ActivationFailMessage returns the fail message of an installed OS version and
+// whether it is installed.
+func (m *Manager) ActivationFailMessage(version string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.failMsgs[version], m.osMap[version]
+}
+
+// SetStandby makes the target a dual supervisor target whose standby
+// supervisor, identified by id, is managed by standby. A nil standby means the
+// standby supervisor is missing.
+func (m *Manager) SetStandby(id string, standby *Manager) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dualSupervisor = true
+	m.standbyID = id
+	m.standby = standby
+}
+
+// Standby returns the manager and id of the standby supervisor, nil if it is
+// missing. dual is false on single supervisor targets.
+func (m *Manager) Standby() (standby *Manager, id string, dual bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.standby, m.standbyID, m.dualSupervisor
+}
+
 // IsInstalled returns true if the OS is installed.
 func (m *Manager) IsInstalled(version string) bool {
 	m.mu.Lock()
@@ -15,7 +15,15 @@ limitations under the License.
 
 package os
 
-import "testing"
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 func TestIsRunning(t *testing.T) {
 	tests := []struct {
@@ -69,3 +77,123 @@ func TestManagerInstall(t *testing.T) {
 		}
 	})
 }
+
+func TestReboot(t *testing.T) {
+	tests := []struct {
+		name,
+		activate,
+		failMsg,
+		wantRunning,
+		wantFailMsg string
+	}{
+		{"Activated OS runs after reboot", "newer", "", "newer", ""},
+		{"Failed activation keeps previous OS", "newer", "Failed to activate OS...", "new", "Failed to activate OS..."},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manager := NewManager("new")
+			manager.SetRunning("new")
+			manager.Install("newer", test.failMsg)
+			if err := manager.Activate(test.activate, true); err != nil {
+				t.Fatalf("Activate(%q, true): %v", test.activate, err)
+			}
+			if running, _ := manager.Running(); running != "new" {
+				t.Errorf("running %q before reboot, want %q", running, "new")
+			}
+			manager.Reboot()
+			running, failMsg := manager.Running()
+			if running != test.wantRunning || failMsg != test.wantFailMsg {
+				t.Errorf("Running() after reboot: got (%q, %q), want (%q, %q)", running, failMsg, test.wantRunning, test.wantFailMsg)
+			}
+		})
+	}
+}
+
+func TestRebootLifecycle(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	start := time.Now()
+	clock := start
+	now = func() time.Time { return clock }
+
+	tests := []struct {
+		name,
+		failMsg,
+		wantRunning,
+		wantFailMsg string
+	}{
+		{"Activated OS runs after boot", "", "newer", ""},
+		{"Failed activation rolls back", "Failed to activate OS...", "new", "Failed to activate OS..."},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock = start
+			manager := NewManager("new")
+			manager.SetRunning("new")
+			manager.Install("newer", test.failMsg)
+			manager.SetTimings(time.Second, time.Minute)
+			if err := manager.Activate("newer", false); err != nil {
+				t.Fatalf("Activate: %v", err)
+			}
+			steps := []struct {
+				at            time.Duration
+				wantRebooting bool
+				wantRunning   string
+			}{
+				{0, false, "new"},
+				{time.Second, true, "new"},
+				{time.Second + time.Minute - 1, true, "new"},
+				{time.Second + time.Minute, false, test.wantRunning},
+			}
+			for _, step := range steps {
+				clock = start.Add(step.at)
+				if got := manager.Rebooting(); got != step.wantRebooting {
+					t.Errorf("Rebooting() at %v: got %v, want %v", step.at, got, step.wantRebooting)
+				}
+				if running, _ := manager.Running(); running != step.wantRunning {
+					t.Errorf("Running() at %v: got %q, want %q", step.at, running, step.wantRunning)
+				}
+			}
+			if _, failMsg := manager.Running(); failMsg != test.wantFailMsg {
+				t.Errorf("activation fail message: got %q, want %q", failMsg, test.wantFailMsg)
+			}
+		})
+	}
+}
+
+func TestActivateNoReboot(t *testing.T) {
+	manager := NewManager("new")
+	manager.SetRunning("new")
+	manager.Install("newer", "")
+	if err := manager.Activate("newer", true); err != nil {
+		t.Fatalf("Activate: %v", err)
+	}
+	if running, _ := manager.Running(); running != "new" {
+		t.Errorf("Running() after activation without reboot: got %q, want %q", running, "new")
+	}
+	manager.Reboot()
+	if running, _ := manager.Running(); running != "newer" {
+		t.Errorf("Running() after reboot: got %q, want %q", running, "newer")
+	}
+	if err := manager.Activate("missing", false); err == nil {
+		t.Error("Activate(missing): got nil error, want NON_EXISTENT_VERSION")
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	manager := NewManager("new")
+	manager.SetRunning("new")
+	manager.Install("newer", "")
+	manager.SetTimings(0, time.Hour)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	if _, err := manager.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Errorf("UnaryInterceptor before reboot: %v", err)
+	}
+	manager.Activate("newer", false)
+	if _, err := manager.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler); status.Code(err) != codes.Unavailable {
+		t.Errorf("UnaryInterceptor while rebooting: got %v, want Unavailable", err)
+	}
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+	if err := manager.StreamInterceptor(nil, nil, &grpc.StreamServerInfo{}, streamHandler); status.Code(err) != codes.Unavailable {
+		t.Errorf("StreamInterceptor while rebooting: got %v, want Unavailable", err)
+	}
+}
@@ -15,7 +15,19 @@ package os
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	log "github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -26,38 +38,241 @@ import (
 
 var receiveChunkSizeAck uint64 = 12000000
 
+// errTransferInProgress is returned when a transfer of the same package is
+// already in progress.
+var errTransferInProgress = errors.New("a transfer of this package is already in progress")
+
 // Server is an OS Management service.
 type Server struct {
 	pb.OSServer
-	manager      *Manager
+	manager *Manager
+	// installToken holds a token for each transfer allowed to run concurrently.
 	installToken chan bool
+	transferDir  string
+	// trustRoots verify the signature of OS packages, nil if they needn't be signed.
+	trustRoots *x509.CertPool
+	// partials are the resumable transfers, by version. Their metadata is
+	// stored next to their files to resume them after the server restarts.
+	partials   map[string]*transfer
+	muPartials sync.Mutex
+}
+
+// transfer is an OS package being received into a file of the transfer
+// directory. Only the received chunk is held in memory.
+type transfer struct {
+	version     string
+	contentHash []byte
+	path        string
+	file        *os.File
+	// size is the number of bytes received and hash their SHA256 hash.
+	size int64
+	hash hash.Hash
+	// inUse is set while a stream is receiving the package.
+	inUse bool
+}
+
+// transferMetadata is the metadata of a partial transfer stored next to its
+// file, in a file with the metadataExt extension.
+type transferMetadata struct {
+	Version     string `json:"version"`
+	ContentHash []byte `json:"content_hash"`
+	Offset      int64  `json:"offset"`
 }
 
+const (
+	transferPrefix = "os-transfer-"
+	metadataExt    = ".json"
+)
+
 // NewServer returns an OS Management service.
 func NewServer(settings *Settings) *Server {
 	if settings.ReceiveChunkSizeAck != 0 {
 		receiveChunkSizeAck = settings.ReceiveChunkSizeAck
 	}
+	maxTransfers := settings.MaxConcurrentTransfers
+	if maxTransfers <= 0 {
+		maxTransfers = 1
+	}
 	server := &Server{
-		manager:      NewManager(settings.FactoryVersion),
-		installToken: make(chan bool, 1),
+		manager:      newManager(settings.FactoryVersion, settings.InstalledVersions, settings),
+		installToken: make(chan bool, maxTransfers),
+		transferDir:  settings.TransferDir,
+		partials:     map[string]*transfer{},
 	}
-	for _, version := range settings.InstalledVersions {
-		server.manager.Install(version, "")
+	if settings.DualSupervisor {
+		var standby *Manager
+		if settings.StandbyID != "" {
+			standby = newManager(settings.FactoryVersion, settings.StandbyInstalledVersions, settings)
+		}
+		server.manager.SetStandby(settings.StandbyID, standby)
 	}
-	server.manager.SetRunning(settings.FactoryVersion)
-	server.installToken <- true
+	if len(settings.TrustBundle) > 0 {
+		server.trustRoots = x509.NewCertPool()
+		for _, cert := range settings.TrustBundle {
+			server.trustRoots.AddCert(cert)
+		}
+	}
+	for i := 0; i < maxTransfers; i++ {
+		server.installToken <- true
+	}
+	server.loadPartials()
 	return server
 }
 
+// loadPartials reloads the partial transfers stored in the transfer directory,
+// dropping those that can't be resumed.
+func (s *Server) loadPartials() {
+	dir := s.transferDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	files, err := filepath.Glob(filepath.Join(dir, transferPrefix+"*"+metadataExt))
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		t, err := loadTransfer(file)
+		if err != nil {
+			log.Errorf("Dropping partial transfer %s: %v", file, err)
+			t.remove()
+			continue
+		}
+		if prev, ok := s.partials[t.version]; ok {
+			// Only one package of a version is resumable.
+			prev.remove()
+		}
+		log.Infof("Loaded partial transfer of OS version %s at %d bytes", t.version, t.size)
+		s.partials[t.version] = t
+	}
+}
+
+// loadTransfer reads the metadata of a partial transfer and hashes the bytes
+// received so far. The returned transfer can be removed even on errors.
+func loadTransfer(metadataFile string) (*transfer, error) {
+	t := &transfer{path: strings.TrimSuffix(metadataFile, metadataExt), hash: sha256.New()}
+	data, err := ioutil.ReadFile(metadataFile)
+	if err != nil {
+		return t, err
+	}
+	var metadata transferMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return t, fmt.Errorf("failed to parse metadata: %v", err)
+	}
+	if metadata.Version == "" || len(metadata.ContentHash) == 0 || metadata.Offset < 0 {
+		return t, errors.New("incomplete metadata")
+	}
+	t.version, t.contentHash = metadata.Version, metadata.ContentHash
+	file, err := os.Open(t.path)
+	if err != nil {
+		return t, err
+	}
+	defer file.Close()
+	if t.size, err = io.CopyN(t.hash, file, metadata.Offset); err != nil {
+		return t, fmt.Errorf("failed to hash %d bytes: %v", metadata.Offset, err)
+	}
+	return t, nil
+}
+
+// LoadTrustBundle reads the PEM encoded CA certificates of a trust bundle.
+func LoadTrustBundle(file string) ([]*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust bundle: %v", err)
+	}
+	var bundle []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate in %s: %v", file, err)
+		}
+		bundle = append(bundle, cert)
+	}
+	if len(bundle) == 0 {
+		return nil, fmt.Errorf("no certificates found in %s", file)
+	}
+	return bundle, nil
+}
+
+// newManager returns a manager running factoryVersion with installedVersions.
+func newManager(factoryVersion string, installedVersions []string, settings *Settings) *Manager {
+	manager := NewManager(factoryVersion)
+	for _, version := range installedVersions {
+		manager.Install(version, "")
+	}
+	manager.SetRunning(factoryVersion)
+	manager.SetTimings(settings.RebootDelay, settings.BootTime)
+	return manager
+}
+
 // Register registers the server into the the gRPC server provided.
 func (s *Server) Register(g *grpc.Server) {
 	pb.RegisterOSServer(g, s)
 }
 
-// Activate sets the requested OS version as the version which is used at the next reboot, and reboots the Target.
+// Manager returns the manager holding the OS state of the server.
+func (s *Server) Manager() *Manager {
+	return s.manager
+}
+
+// Reset drops the resumable transfers if factoryOS or zeroFill is set and, if
+// factoryOS is set, rolls both supervisors back to the factory OS version.
+func (s *Server) Reset(factoryOS, zeroFill bool) error {
+	if !factoryOS && !zeroFill {
+		return nil
+	}
+	s.muPartials.Lock()
+	for version, t := range s.partials {
+		if !t.inUse {
+			t.remove()
+			delete(s.partials, version)
+		}
+	}
+	s.muPartials.Unlock()
+	if !factoryOS {
+		return nil
+	}
+	s.manager.FactoryReset()
+	if standby, _, _ := s.manager.Standby(); standby != nil {
+		standby.FactoryReset()
+	}
+	return nil
+}
+
+// supervisor returns the manager of the supervisor targeted by a request, and
+// of the other supervisor if any.
+func (s *Server) supervisor(standbySupervisor bool) (target, other *Manager, err error) {
+	standby, _, dual := s.manager.Standby()
+	if !standbySupervisor {
+		return s.manager, standby, nil
+	}
+	switch {
+	case !dual:
+		return nil, nil, errors.New("dual supervisors are not supported")
+	case standby == nil:
+		return nil, nil, errors.New("there is no standby supervisor")
+	case standby.Rebooting():
+		return nil, nil, errors.New("the standby supervisor is unavailable")
+	}
+	return standby, s.manager, nil
+}
+
+// Activate sets the requested OS version as the version which is used at the next reboot, and reboots the Target
+// unless no_reboot is set. On dual supervisor targets standby_supervisor targets the standby supervisor instead.
 func (s *Server) Activate(ctx context.Context, request *pb.ActivateRequest) (*pb.ActivateResponse, error) {
-	if err := s.manager.SetRunning(request.Version); err != nil {
+	manager, _, err := s.supervisor(request.StandbySupervisor)
+	if err != nil {
+		return &pb.ActivateResponse{
+			Response: &pb.ActivateResponse_ActivateError{
+				ActivateError: &pb.ActivateError{
+					Type:   pb.ActivateError_UNSPECIFIED,
+					Detail: err.Error(),
+				},
+			}}, nil
+	}
+	if err := manager.Activate(request.Version, request.NoReboot); err != nil {
 		return &pb.ActivateResponse{
 			Response: &pb.ActivateResponse_ActivateError{
 				ActivateError: &pb.ActivateError{
@@ -68,12 +283,62 @@ func (s *Server) Activate(ctx context.Context, request *pb.ActivateRequest) (*pb
 	return &pb.ActivateResponse{Response: &pb.ActivateResponse_ActivateOk{}}, nil
 }
 
-// Verify returns the OS version currently running.
+// Verify returns the OS version currently running, and the state of the standby supervisor on dual supervisor targets.
 func (s *Server) Verify(ctx context.Context, _ *pb.VerifyRequest) (*pb.VerifyResponse, error) {
-	return &pb.VerifyResponse{
-		Version:               s.manager.runningVersion,
-		ActivationFailMessage: s.manager.activationFailMessage,
-	}, nil
+	version, activationFailMsg := s.manager.Running()
+	response := &pb.VerifyResponse{
+		Version:               version,
+		ActivationFailMessage: activationFailMsg,
+	}
+	standby, id, dual := s.manager.Standby()
+	if !dual {
+		return response, nil
+	}
+	switch {
+	case standby == nil:
+		response.VerifyStandby = standbyState(pb.StandbyState_NON_EXISTENT)
+	case standby.Rebooting():
+		response.VerifyStandby = standbyState(pb.StandbyState_UNAVAILABLE)
+	default:
+		version, activationFailMsg := standby.Running()
+		response.VerifyStandby = &pb.VerifyStandby{State: &pb.VerifyStandby_VerifyResponse{
+			VerifyResponse: &pb.StandbyResponse{
+				Id:                    id,
+				Version:               version,
+				ActivationFailMessage: activationFailMsg,
+			},
+		}}
+	}
+	return response, nil
+}
+
+func standbyState(state pb.StandbyState_State) *pb.VerifyStandby {
+	return &pb.VerifyStandby{State: &pb.VerifyStandby_StandbyState{StandbyState: &pb.StandbyState{State: state}}}
+}
+
+// syncPackage copies an installed OS package from one supervisor to the other, reporting the progress to stream. The sync
+// fails if a supervisor reboots.
+func syncPackage(stream pb.OS_InstallServer, from, to *Manager, version string) error {
+	activationFailMsg, _ := from.ActivationFailMessage(version)
+	var response *pb.InstallResponse
+	for percentage := uint32(0); percentage <= 100; percentage += 25 {
+		if from.Rebooting() || to.Rebooting() {
+			response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_SYNC_FAIL}}}
+			log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+			return stream.Send(response)
+		}
+		response = &pb.InstallResponse{Response: &pb.InstallResponse_SyncProgress{
+			SyncProgress: &pb.SyncProgress{PercentageTransferred: percentage},
+		}}
+		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+	to.Install(version, activationFailMsg)
+	response = &pb.InstallResponse{Response: &pb.InstallResponse_Validated{Validated: &pb.Validated{Version: version}}}
+	log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+	return stream.Send(response)
 }
 
 // Install receives an OS package, validates the package and then installs the package.
@@ -89,7 +354,13 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 	if transferRequest == nil {
 		return errors.New("Failed to receive TransferRequest")
 	}
-	if version := transferRequest.Version; s.manager.IsInstalled(version) {
+	manager, other, err := s.supervisor(transferRequest.StandbySupervisor)
+	if err != nil {
+		response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_UNSPECIFIED, Detail: err.Error()}}}
+		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+		return stream.Send(response)
+	}
+	if version := transferRequest.Version; manager.IsInstalled(version) {
 		response = &pb.InstallResponse{Response: &pb.InstallResponse_Validated{
 			Validated: &pb.Validated{
 				Version: version,
@@ -101,6 +372,10 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 		}
 		return nil
 	}
+	if version := transferRequest.Version; other != nil && other.IsInstalled(version) {
+		// The package is synced from the other supervisor instead of transferred.
+		return syncPackage(stream, other, manager, version)
+	}
 	select {
 	case <-s.installToken:
 		defer func() {
@@ -114,17 +389,42 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 		}
 		return errors.New("Another install is already in progress")
 	}
-	response = &pb.InstallResponse{Response: &pb.InstallResponse_TransferReady{TransferReady: &pb.TransferReady{}}}
+	t, err := s.startTransfer(transferRequest.Version, transferRequest.ContentHash)
+	if err != nil {
+		installErr := &pb.InstallError{Type: pb.InstallError_UNSPECIFIED, Detail: err.Error()}
+		if err == errTransferInProgress {
+			installErr.Type = pb.InstallError_INSTALL_IN_PROGRESS
+		}
+		response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: installErr}}
+		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+		return stream.Send(response)
+	}
+	complete := false
+	defer func() {
+		s.endTransfer(transferRequest.Version, t, complete)
+	}()
+	response = &pb.InstallResponse{Response: &pb.InstallResponse_TransferReady{TransferReady: &pb.TransferReady{ResumeOffset: uint64(t.size)}}}
 	log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
 	if err = stream.Send(response); err != nil {
 		return err
 	}
-	bb, err := ReceiveOS(stream)
-	if err != nil {
+	if _, err = receiveOS(stream, t, uint64(t.size)); err != nil {
+		// The partial transfer is kept to be resumed.
 		return err
 	}
-	mockOS := mockos.ValidateOS(bb)
-	if mockOS == nil {
+	complete = true
+	if hash := transferRequest.ContentHash; len(hash) > 0 && !bytes.Equal(t.hash.Sum(nil), hash) {
+		response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL, Detail: "content hash mismatch"}}}
+		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+		return stream.Send(response)
+	}
+	mockOS, err := mockos.ReadOS(t.file, t.size)
+	if err == mockos.ErrFieldTooLarge {
+		response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL, Detail: err.Error()}}}
+		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+		return stream.Send(response)
+	}
+	if err != nil {
 		response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_PARSE_FAIL}}}
 		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
 		if err = stream.Send(response); err != nil {
@@ -133,13 +433,20 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 		return nil
 	}
 	if !mockOS.CheckHash() {
-		response := &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL}}}
+		response := &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL, Detail: "hash of the OS package does not match"}}}
 		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
 		if err = stream.Send(response); err != nil {
 			return err
 		}
 		return nil
 	}
+	if s.trustRoots != nil {
+		if err := mockOS.VerifySignature(s.trustRoots); err != nil {
+			response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL, Detail: err.Error()}}}
+			log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
+			return stream.Send(response)
+		}
+	}
 	if mockOS.Incompatible {
 		response := &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INCOMPATIBLE, Detail: "Unsupported OS Version"}}}
 		log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
@@ -148,7 +455,7 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 		}
 		return nil
 	}
-	if s.manager.IsRunning(mockOS.Version) {
+	if manager.IsRunning(mockOS.Version) {
 		response = &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{
 			InstallError: &pb.InstallError{Type: pb.InstallError_INSTALL_RUN_PACKAGE},
 		}}
@@ -158,7 +465,7 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 		}
 		return nil
 	}
-	s.manager.Install(mockOS.Version, mockOS.ActivationFailMessage)
+	manager.Install(mockOS.Version, mockOS.ActivationFailMessage)
 	response = &pb.InstallResponse{Response: &pb.InstallResponse_Validated{Validated: &pb.Validated{Version: mockOS.Version}}}
 	log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
 	if err = stream.Send(response); err != nil {
@@ -167,33 +474,163 @@ func (s *Server) Install(stream pb.OS_InstallServer) error {
 	return nil
 }
 
+// startTransfer returns the transfer receiving the OS package with the given
+// version and content hash, resuming an interrupted transfer if any. Transfers
+// without a content hash can't be resumed.
+func (s *Server) startTransfer(version string, contentHash []byte) (*transfer, error) {
+	s.muPartials.Lock()
+	defer s.muPartials.Unlock()
+	if t, ok := s.partials[version]; ok && len(contentHash) > 0 {
+		if t.inUse {
+			return nil, errTransferInProgress
+		}
+		if bytes.Equal(t.contentHash, contentHash) {
+			if err := t.open(); err == nil {
+				log.Infof("Resuming transfer of OS version %s at %d bytes", version, t.size)
+				t.inUse = true
+				return t, nil
+			}
+		}
+		// Only the latest package of a version is resumable.
+		t.remove()
+		delete(s.partials, version)
+	}
+	file, err := ioutil.TempFile(s.transferDir, transferPrefix)
+	if err != nil {
+		return nil, err
+	}
+	t := &transfer{version: version, contentHash: contentHash, path: file.Name(), file: file, hash: sha256.New(), inUse: true}
+	if len(contentHash) > 0 {
+		s.partials[version] = t
+	}
+	return t, nil
+}
+
+// endTransfer releases t once its stream ends, removing it unless it can be
+// resumed.
+func (s *Server) endTransfer(version string, t *transfer, complete bool) {
+	s.muPartials.Lock()
+	defer s.muPartials.Unlock()
+	t.inUse = false
+	if complete || len(t.contentHash) == 0 {
+		if s.partials[version] == t {
+			delete(s.partials, version)
+		}
+		t.remove()
+		return
+	}
+	if err := t.file.Close(); err != nil {
+		log.Errorf("Failed to close partial transfer of OS version %s: %v", version, err)
+	}
+	t.file = nil
+	if err := t.save(); err != nil {
+		log.Errorf("Failed to save partial transfer of OS version %s: %v", version, err)
+	}
+}
+
+// save stores the metadata of a resumable transfer next to its file.
+func (t *transfer) save() error {
+	data, err := json.Marshal(&transferMetadata{Version: t.version, ContentHash: t.contentHash, Offset: t.size})
+	if err != nil {
+		return err
+	}
+	// The metadata is replaced atomically to never lose the previous offset.
+	tmp := t.path + metadataExt + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.path+metadataExt)
+}
+
+// open reopens the file of a partial transfer to append to it.
+func (t *transfer) open() error {
+	file, err := os.OpenFile(t.path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	// Drop anything written past the bytes counted as received.
+	if err := file.Truncate(t.size); err != nil {
+		file.Close()
+		return err
+	}
+	if _, err := file.Seek(t.size, io.SeekStart); err != nil {
+		file.Close()
+		return err
+	}
+	t.file = file
+	return nil
+}
+
+// remove deletes the file of t.
+func (t *transfer) remove() {
+	if t.file != nil {
+		t.file.Close()
+		t.file = nil
+	}
+	for _, path := range []string{t.path, t.path + metadataExt} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.Errorf("Failed to remove transfer file %s: %v", path, err)
+		}
+	}
+}
+
+// Write appends p to the file of t, hashing it. The offset of resumable
+// transfers is saved every receiveChunkSizeAck bytes, should the server
+// restart before the stream ends.
+func (t *transfer) Write(p []byte) (int, error) {
+	n, err := t.file.Write(p)
+	t.hash.Write(p[:n])
+	prev := uint64(t.size) / receiveChunkSizeAck
+	t.size += int64(n)
+	if len(t.contentHash) > 0 && uint64(t.size)/receiveChunkSizeAck > prev {
+		if err := t.save(); err != nil {
+			log.Errorf("Failed to save partial transfer of OS version %s: %v", t.version, err)
+		}
+	}
+	return n, err
+}
+
 // ReceiveOS receives and parses requests from stream, storing OS package into a buffer, and updating the progress.
 func ReceiveOS(stream pb.OS_InstallServer) (*bytes.Buffer, error) {
 	bb := &bytes.Buffer{}
-	prev := 0
+	if _, err := receiveOS(stream, bb, 0); err != nil {
+		return nil, err
+	}
+	return bb, nil
+}
+
+// receiveOS writes the OS package received from stream to w, updating the
+// progress. received is the number of bytes received before, and the total is
+// returned.
+func receiveOS(stream pb.OS_InstallServer, w io.Writer, received uint64) (uint64, error) {
+	prev := received / receiveChunkSizeAck
 	for {
 		in, err := stream.Recv()
 		if err != nil {
-			return nil, err
+			return received, err
 		}
 		switch in.Request.(type) {
 		case *pb.InstallRequest_TransferContent:
-			bb.Write(in.GetTransferContent())
+			n, err := w.Write(in.GetTransferContent())
+			received += uint64(n)
+			if err != nil {
+				return received, err
+			}
 		case *pb.InstallRequest_TransferEnd:
 			log.V(1).Info("InstallRequest:\n", proto.MarshalTextString(in))
-			return bb, nil
+			return received, nil
 		default:
 			log.V(1).Info("InstallRequest:\n", proto.MarshalTextString(in))
-			return nil, errors.New("Unknown request type")
+			return received, errors.New("Unknown request type")
 		}
-		if curr := bb.Len() / int(receiveChunkSizeAck); curr > prev {
+		if curr := received / receiveChunkSizeAck; curr > prev {
 			prev = curr
 			response := &pb.InstallResponse{Response: &pb.InstallResponse_TransferProgress{
-				TransferProgress: &pb.TransferProgress{BytesReceived: uint64(bb.Len())},
+				TransferProgress: &pb.TransferProgress{BytesReceived: received},
 			}}
 			log.V(1).Info("InstallResponse:\n", proto.MarshalTextString(response))
 			if err = stream.Send(response); err != nil {
-				return nil, err
+				return received, err
 			}
 		}
 	}
@@ -15,11 +15,19 @@ package os
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/google/gnxi/gnoi/os/pb"
+	"github.com/google/gnxi/utils/entity"
 	"github.com/google/gnxi/utils/mockos"
 	mockosPb "github.com/google/gnxi/utils/mockos/pb"
 	"github.com/google/go-cmp/cmp"
@@ -106,6 +114,7 @@ func TestNewServer(t *testing.T) {
 				osMap:          map[string]bool{"1.0.0a": true},
 				factoryVersion: "1.0.0a",
 				runningVersion: "1.0.0a",
+				activeVersion:  "1.0.0a",
 			},
 		},
 	}
@@ -356,7 +365,7 @@ func TestTargetInstall(t *testing.T) {
 				}},
 			},
 			want: &installResult{
-				res: &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL}}},
+				res: &pb.InstallResponse{Response: &pb.InstallResponse_InstallError{InstallError: &pb.InstallError{Type: pb.InstallError_INTEGRITY_FAIL, Detail: "hash of the OS package does not match"}}},
 			},
 		},
 		{
@@ -446,3 +455,356 @@ func TestMultipleInstalls(t *testing.T) {
 		}
 	})
 }
+
+// recordingStream sends a single TransferRequest and records the responses.
+type recordingStream struct {
+	pb.OS_InstallServer
+	request   *pb.TransferRequest
+	responses []*pb.InstallResponse
+}
+
+func (r *recordingStream) Recv() (*pb.InstallRequest, error) {
+	return &pb.InstallRequest{Request: &pb.InstallRequest_TransferRequest{TransferRequest: r.request}}, nil
+}
+
+func (r *recordingStream) Send(res *pb.InstallResponse) error {
+	r.responses = append(r.responses, res)
+	return nil
+}
+
+func TestStandbySupervisor(t *testing.T) {
+	server := NewServer(&Settings{
+		FactoryVersion:           "1.0.0a",
+		InstalledVersions:        []string{"1.0.1a"},
+		DualSupervisor:           true,
+		StandbyID:                "RP1",
+		StandbyInstalledVersions: []string{"1.0.2a"},
+	})
+	ctx := context.Background()
+
+	// 1.0.1a is synced from the primary supervisor to the standby.
+	stream := &recordingStream{request: &pb.TransferRequest{Version: "1.0.1a", StandbySupervisor: true}}
+	if err := server.Install(stream); err != nil {
+		t.Fatalf("Install: %v", err)
+	}
+	last := stream.responses[len(stream.responses)-1]
+	if len(stream.responses) < 2 || stream.responses[0].GetSyncProgress() == nil || last.GetValidated().GetVersion() != "1.0.1a" {
+		t.Errorf("Install on standby: got %v, want SyncProgress then Validated", stream.responses)
+	}
+
+	if resp, _ := server.Activate(ctx, &pb.ActivateRequest{Version: "1.0.1a", StandbySupervisor: true}); resp.GetActivateError() != nil {
+		t.Fatalf("Activate on standby: got %v, want ActivateOK", resp)
+	}
+	got, _ := server.Verify(ctx, &pb.VerifyRequest{})
+	want := &pb.VerifyResponse{
+		Version: "1.0.0a",
+		VerifyStandby: &pb.VerifyStandby{State: &pb.VerifyStandby_VerifyResponse{
+			VerifyResponse: &pb.StandbyResponse{Id: "RP1", Version: "1.0.1a"},
+		}},
+	}
+	if !Equal(want, got) {
+		t.Errorf("Verify: (-want +got):\n%s", Diff(want, got))
+	}
+	if resp, _ := server.Activate(ctx, &pb.ActivateRequest{Version: "1.0.2a"}); resp.GetActivateError().GetType() != pb.ActivateError_NON_EXISTENT_VERSION {
+		t.Errorf("Activate of a standby only version on primary: got %v, want NON_EXISTENT_VERSION", resp)
+	}
+
+	// Syncing fails while the standby supervisor reboots.
+	standby, _, _ := server.manager.Standby()
+	standby.SetTimings(0, time.Hour)
+	standby.Reboot()
+	stream = &recordingStream{request: &pb.TransferRequest{Version: "1.0.2a"}}
+	if err := server.Install(stream); err != nil {
+		t.Fatalf("Install: %v", err)
+	}
+	if got := stream.responses[len(stream.responses)-1].GetInstallError().GetType(); got != pb.InstallError_SYNC_FAIL {
+		t.Errorf("Install synced from rebooting standby: got %s, want SYNC_FAIL", got)
+	}
+	got, _ = server.Verify(ctx, &pb.VerifyRequest{})
+	if state := got.GetVerifyStandby().GetStandbyState().GetState(); state != pb.StandbyState_UNAVAILABLE {
+		t.Errorf("Verify while standby reboots: got standby state %s, want UNAVAILABLE", state)
+	}
+}
+
+func TestStandbyErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		settings  *Settings
+		wantState pb.StandbyState_State
+	}{
+		{"single supervisor", &Settings{FactoryVersion: "1"}, pb.StandbyState_UNSPECIFIED},
+		{"missing standby", &Settings{FactoryVersion: "1", DualSupervisor: true}, pb.StandbyState_NON_EXISTENT},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewServer(test.settings)
+			resp, _ := server.Activate(context.Background(), &pb.ActivateRequest{Version: "1", StandbySupervisor: true})
+			if resp.GetActivateError().GetType() != pb.ActivateError_UNSPECIFIED || resp.GetActivateError().GetDetail() == "" {
+				t.Errorf("Activate on standby: got %v, want UNSPECIFIED error with detail", resp)
+			}
+			stream := &recordingStream{request: &pb.TransferRequest{Version: "1", StandbySupervisor: true}}
+			server.Install(stream)
+			if got := stream.responses[0].GetInstallError().GetType(); got != pb.InstallError_UNSPECIFIED {
+				t.Errorf("Install on standby: got %v, want UNSPECIFIED error", stream.responses)
+			}
+			verify, _ := server.Verify(context.Background(), &pb.VerifyRequest{})
+			if got := verify.GetVerifyStandby().GetStandbyState().GetState(); got != test.wantState {
+				t.Errorf("Verify: got standby state %s, want %s", got, test.wantState)
+			}
+		})
+	}
+}
+
+// scriptedStream receives requests in order, then fails with err.
+type scriptedStream struct {
+	pb.OS_InstallServer
+	requests  []*pb.InstallRequest
+	err       error
+	responses []*pb.InstallResponse
+}
+
+func (s *scriptedStream) Recv() (*pb.InstallRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, s.err
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *scriptedStream) Send(res *pb.InstallResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func TestResumeInstall(t *testing.T) {
+	oS := &mockos.OS{MockOS: mockosPb.MockOS{
+		Version: "1.0.2a",
+		Cookie:  "cookiestring",
+		Padding: make([]byte, 1000),
+	}}
+	oS.Hash()
+	image, _ := proto.Marshal(&oS.MockOS)
+	hash := sha256.Sum256(image)
+	transferRequest := &pb.InstallRequest{Request: &pb.InstallRequest_TransferRequest{
+		TransferRequest: &pb.TransferRequest{Version: oS.Version, ContentHash: hash[:]},
+	}}
+	content := func(b []byte) *pb.InstallRequest {
+		return &pb.InstallRequest{Request: &pb.InstallRequest_TransferContent{TransferContent: b}}
+	}
+	dir, err := ioutil.TempDir("", "os-transfers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	server := NewServer(&Settings{FactoryVersion: "1.0.0a", TransferDir: dir})
+
+	half := len(image) / 2
+	interrupted := &scriptedStream{
+		requests: []*pb.InstallRequest{transferRequest, content(image[:half])},
+		err:      errors.New("connection reset"),
+	}
+	if err := server.Install(interrupted); err == nil {
+		t.Fatal("Install of interrupted stream: got nil error, want error")
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 2 || files[0].Size() != int64(half) {
+		t.Fatalf("interrupted transfer and its metadata not kept in %s", dir)
+	}
+
+	// The transfer is resumed by a restarted server.
+	server = NewServer(&Settings{FactoryVersion: "1.0.0a", TransferDir: dir})
+	resumed := &scriptedStream{requests: []*pb.InstallRequest{
+		transferRequest,
+		content(image[half:]),
+		{Request: &pb.InstallRequest_TransferEnd{}},
+	}}
+	if err := server.Install(resumed); err != nil {
+		t.Fatalf("Install of resumed stream: %v", err)
+	}
+	if got := resumed.responses[0].GetTransferReady().GetResumeOffset(); got != uint64(half) {
+		t.Errorf("TransferReady resume offset: got %d, want %d", got, half)
+	}
+	if last := resumed.responses[len(resumed.responses)-1]; last.GetValidated().GetVersion() != oS.Version {
+		t.Errorf("Install of resumed stream: got %v, want Validated", last)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(server.partials) != 0 || len(files) != 0 {
+		t.Errorf("transfers kept after the install completed: %v", files)
+	}
+}
+
+func TestResumeInstallHashMismatch(t *testing.T) {
+	server := NewServer(&Settings{FactoryVersion: "1.0.0a"})
+	stream := &scriptedStream{requests: []*pb.InstallRequest{
+		{Request: &pb.InstallRequest_TransferRequest{TransferRequest: &pb.TransferRequest{Version: "1.0.2a", ContentHash: []byte("BADHASH")}}},
+		{Request: &pb.InstallRequest_TransferContent{TransferContent: []byte("image")}},
+		{Request: &pb.InstallRequest_TransferEnd{}},
+	}}
+	server.Install(stream)
+	if got := stream.responses[len(stream.responses)-1].GetInstallError().GetType(); got != pb.InstallError_INTEGRITY_FAIL {
+		t.Errorf("Install with wrong content hash: got %s, want INTEGRITY_FAIL", got)
+	}
+}
+
+func TestConcurrentTransfers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "os-transfers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	server := NewServer(&Settings{FactoryVersion: "1.0.0a", TransferDir: dir, MaxConcurrentTransfers: 2})
+	first, err := server.startTransfer("1.0.2a", []byte("hash"))
+	if err != nil {
+		t.Fatalf("startTransfer: %v", err)
+	}
+	if _, err := server.startTransfer("1.0.2a", []byte("hash")); err != errTransferInProgress {
+		t.Errorf("startTransfer of a package in transfer: got %v, want %v", err, errTransferInProgress)
+	}
+	second, err := server.startTransfer("1.0.3a", nil)
+	if err != nil {
+		t.Fatalf("startTransfer: %v", err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 2 {
+		t.Errorf("got %d transfer files, want 2", len(files))
+	}
+	server.endTransfer("1.0.3a", second, false)
+	server.endTransfer("1.0.2a", first, true)
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("transfer files kept after the transfers ended: %v", files)
+	}
+	if got := len(server.installToken); got != 2 {
+		t.Errorf("got %d install tokens, want 2", got)
+	}
+}
+
+func TestSignedInstall(t *testing.T) {
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := entity.CreateSigned("signer", nil, ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+	untrusted, err := entity.CreateSelfSigned("untrusted", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	image := func(version string, signer *entity.Entity) []byte {
+		oS := &mockos.OS{MockOS: mockosPb.MockOS{Version: version, Cookie: "cookiestring", Padding: make([]byte, 100)}}
+		oS.Hash()
+		if signer != nil {
+			if err := oS.Sign(signer); err != nil {
+				t.Fatal(err)
+			}
+		}
+		out, _ := proto.Marshal(&oS.MockOS)
+		return out
+	}
+	tests := []struct {
+		name       string
+		image      []byte
+		wantDetail string
+	}{
+		{name: "signed", image: image("1.0.1a", signer)},
+		{name: "unsigned", image: image("1.0.2a", nil), wantDetail: "OS package is not signed"},
+		{name: "untrusted signer", image: image("1.0.3a", untrusted), wantDetail: `untrusted signer "untrusted"`},
+	}
+	server := NewServer(&Settings{FactoryVersion: "1.0.0a", TrustBundle: []*x509.Certificate{ca.Certificate.Leaf}})
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stream := &scriptedStream{requests: []*pb.InstallRequest{
+				{Request: &pb.InstallRequest_TransferRequest{TransferRequest: &pb.TransferRequest{Version: test.name}}},
+				{Request: &pb.InstallRequest_TransferContent{TransferContent: test.image}},
+				{Request: &pb.InstallRequest_TransferEnd{}},
+			}}
+			if err := server.Install(stream); err != nil {
+				t.Fatalf("Install: %v", err)
+			}
+			last := stream.responses[len(stream.responses)-1]
+			if test.wantDetail == "" {
+				if last.GetValidated() == nil {
+					t.Errorf("Install: got %v, want Validated", last)
+				}
+				return
+			}
+			installErr := last.GetInstallError()
+			if installErr.GetType() != pb.InstallError_INTEGRITY_FAIL || !strings.HasPrefix(installErr.GetDetail(), test.wantDetail) {
+				t.Errorf("Install: got %v, want INTEGRITY_FAIL %q", last, test.wantDetail)
+			}
+		})
+	}
+}
+
+func TestLoadTrustBundle(t *testing.T) {
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := ioutil.TempFile("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: ca.Certificate.Leaf.Raw})
+	file.Close()
+	bundle, err := LoadTrustBundle(file.Name())
+	if err != nil {
+		t.Fatalf("LoadTrustBundle: %v", err)
+	}
+	if len(bundle) != 1 || !bundle[0].Equal(ca.Certificate.Leaf) {
+		t.Errorf("LoadTrustBundle: got %v, want the CA certificate", bundle)
+	}
+	if _, err := LoadTrustBundle(os.DevNull); err == nil {
+		t.Error("LoadTrustBundle of an empty file: got nil error, want error")
+	}
+}
+
+func TestReset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "os-transfers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	server := NewServer(&Settings{
+		FactoryVersion:           "1.0.0a",
+		InstalledVersions:        []string{"1.0.1a"},
+		TransferDir:              dir,
+		DualSupervisor:           true,
+		StandbyID:                "RP1",
+		StandbyInstalledVersions: []string{"1.0.1a"},
+	})
+	standby, _, _ := server.Manager().Standby()
+	for _, manager := range []*Manager{server.Manager(), standby} {
+		manager.Activate("1.0.1a", false)
+	}
+	partial, err := server.startTransfer("1.0.2a", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.endTransfer("1.0.2a", partial, false)
+
+	if err := server.Reset(false, false); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if len(server.partials) != 1 {
+		t.Errorf("Reset without factory OS nor zero fill dropped partial transfers")
+	}
+	if err := server.Reset(false, true); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(server.partials) != 0 || len(files) != 0 {
+		t.Errorf("Reset with zero fill kept partial transfers: %v", files)
+	}
+	if running, _ := server.Manager().Running(); running != "1.0.1a" {
+		t.Errorf("Reset without factory OS: running %q, want 1.0.1a", running)
+	}
+
+	if err := server.Reset(true, false); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	for name, manager := range map[string]*Manager{"active": server.Manager(), "standby": standby} {
+		if running, _ := manager.Running(); running != "1.0.0a" || manager.IsInstalled("1.0.1a") {
+			t.Errorf("Reset with factory OS: %s supervisor runs %q with 1.0.1a installed %v, want 1.0.0a only", name, running, manager.IsInstalled("1.0.1a"))
+		}
+	}
+}
@@ -17,6 +17,7 @@ package reset
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/gnxi/gnoi/reset/pb"
 	"google.golang.org/grpc"
@@ -31,11 +32,17 @@ type Settings struct {
 // Notifier for reset callback.
 type Notifier func()
 
+// Wiper wipes the state of the target on a factory reset. It rolls the OS back
+// to the factory version if factoryOS is set and zero fills persistent storage
+// if zeroFill is set.
+type Wiper func(factoryOS, zeroFill bool) error
+
 // Server for factory_reset service.
 type Server struct {
 	pb.FactoryResetServer
 	*Settings
 	notifier Notifier
+	wipers   []Wiper
 }
 
 // NewServer generates a new factory reset server.
@@ -48,7 +55,14 @@ func (s *Server) Register(g *grpc.Server) {
 	pb.RegisterFactoryResetServer(g, s)
 }
 
-// Start rpc will start the factory reset process.
+// RegisterWiper registers a function called by every factory reset to wipe the
+// state of the target.
+func (s *Server) RegisterWiper(w Wiper) {
+	s.wipers = append(s.wipers, w)
+}
+
+// Start rpc will wipe the state of the target, then call the notifier to
+// complete the factory reset.
 func (s *Server) Start(ctx context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
 	resetError := &pb.ResetError{}
 	resetError.ZeroFillUnsupported = req.ZeroFill && s.ZeroFillUnsupported
@@ -58,7 +72,21 @@ func (s *Server) Start(ctx context.Context, req *pb.StartRequest) (*pb.StartResp
 		return &pb.StartResponse{Response: &pb.StartResponse_ResetError{ResetError: resetError}}, nil
 	}
 
-	go s.notifier()
+	var details []string
+	for _, wipe := range s.wipers {
+		if err := wipe(req.FactoryOs, req.ZeroFill); err != nil {
+			details = append(details, err.Error())
+		}
+	}
+	if len(details) > 0 {
+		resetError.Other = true
+		resetError.Detail = strings.Join(details, "; ")
+		return &pb.StartResponse{Response: &pb.StartResponse_ResetError{ResetError: resetError}}, nil
+	}
+
+	if s.notifier != nil {
+		go s.notifier()
+	}
 
 	return &pb.StartResponse{Response: &pb.StartResponse_ResetSuccess{}}, nil
 }
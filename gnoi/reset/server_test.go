@@ -17,6 +17,7 @@ package reset
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -102,3 +103,65 @@ func TestStart(t *testing.T) {
 		})
 	}
 }
+
+func TestStartWipers(t *testing.T) {
+	tests := []struct {
+		name       string
+		wipers     []Wiper
+		wantDetail string
+	}{
+		{
+			name:   "wiped",
+			wipers: []Wiper{func(bool, bool) error { return nil }},
+		},
+		{
+			name: "wipe failures",
+			wipers: []Wiper{
+				func(bool, bool) error { return errors.New("os: busy") },
+				func(bool, bool) error { return nil },
+				func(bool, bool) error { return errors.New("file: read-only") },
+			},
+			wantDetail: "os: busy; file: read-only",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := make(chan bool, 1)
+			s := NewServer(&Settings{}, func() { called <- true })
+			var gotFactoryOS, gotZeroFill bool
+			s.RegisterWiper(func(factoryOS, zeroFill bool) error {
+				gotFactoryOS, gotZeroFill = factoryOS, zeroFill
+				return nil
+			})
+			for _, w := range test.wipers {
+				s.RegisterWiper(w)
+			}
+			resp, err := s.Start(context.Background(), &pb.StartRequest{FactoryOs: true, ZeroFill: true})
+			if err != nil {
+				t.Fatalf("Start: %v", err)
+			}
+			if !gotFactoryOS || !gotZeroFill {
+				t.Errorf("Start: wiper called with factoryOS %v and zeroFill %v, want true", gotFactoryOS, gotZeroFill)
+			}
+			if test.wantDetail == "" {
+				if resp.GetResetError() != nil {
+					t.Errorf("Start: got %v, want ResetSuccess", resp)
+				}
+				select {
+				case <-called:
+				case <-time.After(100 * time.Millisecond):
+					t.Error("Reset never called")
+				}
+				return
+			}
+			if resetErr := resp.GetResetError(); !resetErr.GetOther() || resetErr.GetDetail() != test.wantDetail {
+				t.Errorf("Start: got %v, want ResetError with detail %q", resp, test.wantDetail)
+			}
+			select {
+			case <-called:
+				t.Error("Reset called after a failed wipe")
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gnoi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// Service is a gNOI service served by Server.
+type Service interface {
+	// Register registers the service into the gRPC server provided.
+	Register(g *grpc.Server)
+}
+
+// Resetter is implemented by services whose state is wiped by factory resets.
+type Resetter interface {
+	// Reset wipes the state of the service. It rolls the OS back to the factory
+	// version if factoryOS is set and zero fills persistent storage if zeroFill
+	// is set.
+	Reset(factoryOS, zeroFill bool) error
+}
+
+// ServiceFactory creates a Service for a new Server.
+type ServiceFactory func() (Service, error)
+
+// service is a Service registered in a Server.
+type service struct {
+	name    string
+	service Service
+	// bootstrapping services are also served before the target has credentials.
+	bootstrapping bool
+}
+
+type factory struct {
+	name          string
+	create        ServiceFactory
+	bootstrapping bool
+}
+
+var (
+	muFactories sync.Mutex
+	factories   []*factory
+)
+
+// RegisterService makes every Server created afterwards serve the service
+// created by f under name, typically from an init function. Unlike
+// Server.AddService, each Server gets its own service. It panics if name is
+// registered twice.
+func RegisterService(name string, bootstrapping bool, f ServiceFactory) {
+	muFactories.Lock()
+	defer muFactories.Unlock()
+	for _, registered := range factories {
+		if registered.name == name {
+			panic(fmt.Sprintf("gnoi: service %q registered twice", name))
+		}
+	}
+	factories = append(factories, &factory{name: name, create: f, bootstrapping: bootstrapping})
+}
+
+// addRegisteredServices adds the services created by the registered factories.
+func (s *Server) addRegisteredServices() error {
+	muFactories.Lock()
+	defer muFactories.Unlock()
+	for _, f := range factories {
+		svc, err := f.create()
+		if err != nil {
+			return fmt.Errorf("failed to create %s service: %v", f.name, err)
+		}
+		if err := s.AddService(f.name, svc, f.bootstrapping); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddService adds a service served by the gRPC servers set up with Register.
+// If bootstrapping is true it is also served by those set up with
+// RegisterBootstrapping, before the target has credentials. Services must be
+// added before the gRPC servers are set up.
+func (s *Server) AddService(name string, svc Service, bootstrapping bool) error {
+	for _, registered := range s.services {
+		if registered.name == name {
+			return fmt.Errorf("service %q already added", name)
+		}
+	}
+	s.services = append(s.services, &service{name: name, service: svc, bootstrapping: bootstrapping})
+	return nil
+}
+
+// reset wipes the certificates of the target and the state of the services
+// implementing Resetter, reporting every failure.
+func (s *Server) reset(factoryOS, zeroFill bool) error {
+	var failures []string
+	if err := s.certManager.Reset(); err != nil {
+		failures = append(failures, fmt.Sprintf("cert: %v", err))
+	}
+	for _, registered := range s.services {
+		r, ok := registered.service.(Resetter)
+		if !ok {
+			continue
+		}
+		if err := r.Reset(factoryOS, zeroFill); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", registered.name, err))
+		}
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// Services returns the names of the services of the server, in the order they
+// were added.
+func (s *Server) Services() []string {
+	names := make([]string, 0, len(s.services))
+	for _, registered := range s.services {
+		names = append(names, registered.name)
+	}
+	return names
+}
+
+// Register all added gRPC services.
+func (s *Server) Register(g *grpc.Server) {
+	for _, registered := range s.services {
+		registered.service.Register(g)
+	}
+}
+
+// RegisterBootstrapping registers only the services available during
+// bootstrapping in the gRPC Server.
+func (s *Server) RegisterBootstrapping(g *grpc.Server) {
+	for _, registered := range s.services {
+		if registered.bootstrapping {
+			registered.service.Register(g)
+		}
+	}
+}
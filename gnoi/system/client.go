@@ -0,0 +1,129 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"context"
+	"io"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/gnoi/system/pb"
+	"google.golang.org/grpc"
+)
+
+// Client handles requesting System RPCs.
+type Client struct {
+	client pb.SystemClient
+}
+
+// NewClient returns a new System service client.
+func NewClient(c *grpc.ClientConn) *Client {
+	return &Client{client: pb.NewSystemClient(c)}
+}
+
+// Reboot invokes the Reboot RPC for the System service.
+func (c *Client) Reboot(ctx context.Context, method pb.RebootMethod, delay time.Duration, message string, force bool) error {
+	request := &pb.RebootRequest{
+		Method:  method,
+		Delay:   uint64(delay),
+		Message: message,
+		Force:   force,
+	}
+	log.V(1).Info("RebootRequest:\n", proto.MarshalTextString(request))
+	_, err := c.client.Reboot(ctx, request)
+	return err
+}
+
+// RebootStatus invokes the RebootStatus RPC for the System service.
+func (c *Client) RebootStatus(ctx context.Context) (*pb.RebootStatusResponse, error) {
+	response, err := c.client.RebootStatus(ctx, &pb.RebootStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("RebootStatusResponse:\n", proto.MarshalTextString(response))
+	return response, nil
+}
+
+// CancelReboot invokes the CancelReboot RPC for the System service.
+func (c *Client) CancelReboot(ctx context.Context, message string) error {
+	request := &pb.CancelRebootRequest{Message: message}
+	log.V(1).Info("CancelRebootRequest:\n", proto.MarshalTextString(request))
+	_, err := c.client.CancelReboot(ctx, request)
+	return err
+}
+
+// Time invokes the Time RPC for the System service.
+func (c *Client) Time(ctx context.Context) (time.Time, error) {
+	response, err := c.client.Time(ctx, &pb.TimeRequest{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(response.Time)), nil
+}
+
+// SwitchControlProcessor invokes the SwitchControlProcessor RPC for the System
+// service, switching to the control processor with the given name.
+func (c *Client) SwitchControlProcessor(ctx context.Context, name string) (*pb.SwitchControlProcessorResponse, error) {
+	request := &pb.SwitchControlProcessorRequest{ControlProcessor: ComponentPath(name)}
+	log.V(1).Info("SwitchControlProcessorRequest:\n", proto.MarshalTextString(request))
+	response, err := c.client.SwitchControlProcessor(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("SwitchControlProcessorResponse:\n", proto.MarshalTextString(response))
+	return response, nil
+}
+
+// Ping invokes the Ping RPC for the System service, calling f with every response.
+func (c *Client) Ping(ctx context.Context, request *pb.PingRequest, f func(*pb.PingResponse)) error {
+	log.V(1).Info("PingRequest:\n", proto.MarshalTextString(request))
+	stream, err := c.client.Ping(ctx, request)
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f(response)
+	}
+}
+
+// Traceroute invokes the Traceroute RPC for the System service, calling f with
+// every response.
+func (c *Client) Traceroute(ctx context.Context, request *pb.TracerouteRequest, f func(*pb.TracerouteResponse)) error {
+	log.V(1).Info("TracerouteRequest:\n", proto.MarshalTextString(request))
+	stream, err := c.client.Traceroute(ctx, request)
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f(response)
+	}
+}
@@ -0,0 +1,78 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/gnxi/gnoi/system/pb"
+	"google.golang.org/grpc"
+)
+
+func newTestClient(t *testing.T) (*Client, func()) {
+	t.Helper()
+	s, _ := newTestServer(t, &Settings{})
+	g := grpc.NewServer()
+	s.Register(g)
+	listen, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal("server failed to listen:", err)
+	}
+	go g.Serve(listen)
+	conn, err := grpc.Dial(listen.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal("failed to dial:", err)
+	}
+	return NewClient(conn), func() {
+		conn.Close()
+		g.Stop()
+	}
+}
+
+func TestClient(t *testing.T) {
+	c, stop := newTestClient(t)
+	defer stop()
+	ctx := context.Background()
+
+	if now, err := c.Time(ctx); err != nil || time.Since(now) > time.Minute {
+		t.Errorf("Time: got %v, %v", now, err)
+	}
+	if err := c.Reboot(ctx, pb.RebootMethod_COLD, time.Hour, "test", false); err != nil {
+		t.Fatalf("Reboot: %v", err)
+	}
+	status, err := c.RebootStatus(ctx)
+	if err != nil || !status.Active || status.Reason != "test" {
+		t.Errorf("RebootStatus: got %v, %v, want active reboot", status, err)
+	}
+	if err := c.CancelReboot(ctx, "test"); err != nil {
+		t.Errorf("CancelReboot: %v", err)
+	}
+	if resp, err := c.SwitchControlProcessor(ctx, "RP1"); err != nil || ComponentName(resp.ControlProcessor) != "RP1" {
+		t.Errorf("SwitchControlProcessor: got %v, %v", resp, err)
+	}
+
+	var pings int
+	if err := c.Ping(ctx, &pb.PingRequest{Destination: "192.0.2.200", Count: 2, Interval: 1}, func(*pb.PingResponse) { pings++ }); err != nil || pings != 3 {
+		t.Errorf("Ping: got %d responses, %v, want 3 responses", pings, err)
+	}
+	var hops int
+	if err := c.Traceroute(ctx, &pb.TracerouteRequest{Destination: "192.0.2.200"}, func(*pb.TracerouteResponse) { hops++ }); err != nil || hops != defaultHops+1 {
+		t.Errorf("Traceroute: got %d responses, %v, want %d responses", hops, err, defaultHops+1)
+	}
+}
@@ -0,0 +1,39 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	gnmiPb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// ComponentPath returns the path of the component with the given name,
+// /components/component[name=<name>].
+func ComponentPath(name string) *gnmiPb.Path {
+	return &gnmiPb.Path{Elem: []*gnmiPb.PathElem{
+		{Name: "components"},
+		{Name: "component", Key: map[string]string{"name": name}},
+	}}
+}
+
+// ComponentName returns the name of the component in path, or "" if path is
+// not a component path.
+func ComponentName(path *gnmiPb.Path) string {
+	elems := path.GetElem()
+	if len(elems) == 0 || elems[len(elems)-1].Name != "component" {
+		return ""
+	}
+	return elems[len(elems)-1].Key["name"]
+}
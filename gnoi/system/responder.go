@@ -0,0 +1,236 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net"
+	"time"
+
+	"github.com/google/gnxi/gnoi/system/pb"
+)
+
+const (
+	defaultHops         = 3
+	defaultRTT          = time.Millisecond
+	defaultPingCount    = 5
+	defaultPingInterval = time.Second
+	defaultPingSize     = 56
+	defaultMaxTTL       = 30
+	tracerouteSize      = 60
+	initialPacketTTL    = 64
+)
+
+// Responder simulates the network reachable from the target for Ping and
+// Traceroute. Every reachable destination is Hops hops away and each hop adds
+// RTT to the round trip time.
+type Responder struct {
+	// Hops to any reachable destination, 3 by default.
+	Hops int
+	// RTT added by each hop, 1ms by default.
+	RTT time.Duration
+	// Unreachable destinations, by name or address.
+	Unreachable []string
+}
+
+func (r *Responder) hops() int {
+	if r.Hops <= 0 {
+		return defaultHops
+	}
+	return r.Hops
+}
+
+// rtt returns the round trip time to hop for the probe with sequence number seq.
+func (r *Responder) rtt(hop, seq int) time.Duration {
+	rtt := r.RTT
+	if rtt <= 0 {
+		rtt = defaultRTT
+	}
+	return time.Duration(hop)*rtt + time.Duration(seq%4)*rtt/10
+}
+
+func (r *Responder) isUnreachable(name, address string) bool {
+	for _, u := range r.Unreachable {
+		if u == name || u == address {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns the name and address of destination. Names are resolved to
+// documentation addresses derived from the name.
+func resolve(destination string, l3 pb.L3Protocol) (name, address string) {
+	if ip := net.ParseIP(destination); ip != nil {
+		return destination, ip.String()
+	}
+	h := fnv.New32a()
+	h.Write([]byte(destination))
+	sum := h.Sum32()
+	if l3 == pb.L3Protocol_IPV6 {
+		return destination, fmt.Sprintf("2001:db8:1::%x", sum&0xffff)
+	}
+	return destination, fmt.Sprintf("198.51.100.%d", sum%254+1)
+}
+
+// hopAddress returns the address of an intermediate hop.
+func hopAddress(hop int, ipv6 bool) string {
+	if ipv6 {
+		return fmt.Sprintf("2001:db8::%d", hop)
+	}
+	return fmt.Sprintf("192.0.2.%d", hop)
+}
+
+// Ping sends the responses of the simulated ping to send, ending with the
+// summary statistics. A count of -1 pings until ctx is done.
+func (r *Responder) Ping(ctx context.Context, request *pb.PingRequest, send func(*pb.PingResponse) error) error {
+	_, address := resolve(request.Destination, request.L3Protocol)
+	count := int(request.Count)
+	if count == 0 {
+		count = defaultPingCount
+	}
+	interval := time.Duration(request.Interval)
+	switch {
+	case interval == 0:
+		interval = defaultPingInterval
+	case interval < 0:
+		interval = 0
+	}
+	size := request.Size
+	if size == 0 {
+		size = defaultPingSize
+	}
+	unreachable := r.isUnreachable(request.Destination, address)
+	hops := r.hops()
+
+	start := now()
+	var sent, received int32
+	var sum, sumSquares float64
+	var minTime, maxTime time.Duration
+loop:
+	for seq := 1; count < 0 || seq <= count; seq++ {
+		if seq > 1 {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-time.After(interval):
+			}
+		}
+		sent++
+		if unreachable {
+			continue
+		}
+		rtt := r.rtt(hops, seq)
+		if received == 0 || rtt < minTime {
+			minTime = rtt
+		}
+		if rtt > maxTime {
+			maxTime = rtt
+		}
+		received++
+		sum += float64(rtt)
+		sumSquares += float64(rtt) * float64(rtt)
+		if err := send(&pb.PingResponse{
+			Source:   address,
+			Time:     int64(rtt),
+			Bytes:    size + 8,
+			Sequence: int32(seq),
+			Ttl:      int32(initialPacketTTL - hops),
+		}); err != nil {
+			return err
+		}
+	}
+	summary := &pb.PingResponse{
+		Source:   address,
+		Time:     int64(now().Sub(start)),
+		Sent:     sent,
+		Received: received,
+	}
+	if received > 0 {
+		avg := sum / float64(received)
+		summary.MinTime = int64(minTime)
+		summary.AvgTime = int64(avg)
+		summary.MaxTime = int64(maxTime)
+		summary.StdDev = int64(math.Sqrt(math.Max(sumSquares/float64(received)-avg*avg, 0)))
+	}
+	return send(summary)
+}
+
+// Traceroute sends the responses of the simulated traceroute to send. Traces
+// to unreachable destinations end at the first hop with NETWORK_UNREACHABLE.
+func (r *Responder) Traceroute(ctx context.Context, request *pb.TracerouteRequest, send func(*pb.TracerouteResponse) error) error {
+	name, address := resolve(request.Destination, request.L3Protocol)
+	ipv6 := net.ParseIP(address).To4() == nil
+	initialTTL := int(request.InitialTtl)
+	if initialTTL == 0 {
+		initialTTL = 1
+	}
+	maxTTL := int(request.MaxTtl)
+	if maxTTL <= 0 {
+		maxTTL = defaultMaxTTL
+	}
+	if err := send(&pb.TracerouteResponse{
+		DestinationName:    name,
+		DestinationAddress: address,
+		Hops:               int32(maxTTL),
+		PacketSize:         tracerouteSize,
+	}); err != nil {
+		return err
+	}
+	unreachable := r.isUnreachable(request.Destination, address)
+	hops := r.hops()
+	for hop := initialTTL; hop <= maxTTL; hop++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		response := &pb.TracerouteResponse{
+			Hop:     int32(hop),
+			Address: hopAddress(hop, ipv6),
+			Rtt:     int64(r.rtt(hop, hop)),
+		}
+		if !request.DoNotResolve {
+			response.Name = fmt.Sprintf("hop%d.example.net", hop)
+		}
+		last := false
+		switch {
+		case unreachable:
+			response.Address = hopAddress(1, ipv6)
+			response.Rtt = int64(r.rtt(1, hop))
+			if !request.DoNotResolve {
+				response.Name = "hop1.example.net"
+			}
+			response.State = pb.TracerouteResponse_NETWORK_UNREACHABLE
+			last = true
+		case hop >= hops:
+			response.Address = address
+			response.Name = ""
+			if !request.DoNotResolve {
+				response.Name = name
+			}
+			last = true
+		}
+		if err := send(response); err != nil {
+			return err
+		}
+		if last {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package system implements the gNOI System service.
+package system
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/gnoi/os"
+	"github.com/google/gnxi/gnoi/system/pb"
+	gnmiPb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var now = time.Now
+
+// Settings for configurable options in Server.
+type Settings struct {
+	// ControlProcessors are the names of the control processors of the
+	// target, the first one is the active one. Defaults to RP0 and RP1.
+	ControlProcessors []string
+	// RebootMethods are the supported reboot methods. Defaults to COLD.
+	RebootMethods []pb.RebootMethod
+	// Responder simulates the network for Ping and Traceroute.
+	Responder *Responder
+}
+
+// Server is a System service.
+type Server struct {
+	pb.SystemServer
+	osManager *os.Manager
+	responder *Responder
+	methods   map[pb.RebootMethod]bool
+
+	mu              sync.Mutex
+	processors      []string
+	activeProcessor string
+	bootTime        time.Time
+	rebootCount     uint32
+	rebootTimer     *time.Timer
+	rebootWhen      time.Time
+	rebootReason    string
+	rebootMethod    pb.RebootMethod
+}
+
+// NewServer returns a System service. Reboots boot the active OS of osManager.
+func NewServer(settings *Settings, osManager *os.Manager) *Server {
+	processors := settings.ControlProcessors
+	if len(processors) == 0 {
+		processors = []string{"RP0", "RP1"}
+	}
+	methods := map[pb.RebootMethod]bool{}
+	for _, method := range settings.RebootMethods {
+		methods[method] = true
+	}
+	if len(methods) == 0 {
+		methods[pb.RebootMethod_COLD] = true
+	}
+	responder := settings.Responder
+	if responder == nil {
+		responder = &Responder{}
+	}
+	return &Server{
+		osManager:       osManager,
+		responder:       responder,
+		methods:         methods,
+		processors:      processors,
+		activeProcessor: processors[0],
+		bootTime:        now(),
+	}
+}
+
+// Register registers the server into the gRPC server provided.
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterSystemServer(g, s)
+}
+
+// Time returns the current time on the target.
+func (s *Server) Time(ctx context.Context, _ *pb.TimeRequest) (*pb.TimeResponse, error) {
+	return &pb.TimeResponse{Time: uint64(now().UnixNano())}, nil
+}
+
+// Reboot reboots the target after the requested delay. Reboots without delay
+// complete before returning.
+func (s *Server) Reboot(ctx context.Context, request *pb.RebootRequest) (*pb.RebootResponse, error) {
+	log.V(1).Info("RebootRequest:\n", proto.MarshalTextString(request))
+	if request.Method == pb.RebootMethod_UNKNOWN {
+		return nil, status.Error(codes.InvalidArgument, "reboot method must be specified")
+	}
+	if !s.methods[request.Method] {
+		return nil, status.Errorf(codes.Unimplemented, "reboot method %s is not supported", request.Method)
+	}
+	rebootsActive := len(request.Subcomponents) == 0
+	for _, path := range request.Subcomponents {
+		name, err := s.controlProcessor(path)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		rebootsActive = rebootsActive || name == s.activeProcessor
+		s.mu.Unlock()
+	}
+	if !rebootsActive {
+		log.Infof("Rebooted standby control processors: %v", request.Subcomponents)
+		return &pb.RebootResponse{}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rebootTimer != nil {
+		return nil, status.Error(codes.FailedPrecondition, "a reboot is already pending")
+	}
+	if request.Delay == 0 {
+		s.reboot(request.Message)
+		return &pb.RebootResponse{}, nil
+	}
+	delay := time.Duration(request.Delay)
+	s.rebootWhen = now().Add(delay)
+	s.rebootReason = request.Message
+	s.rebootMethod = request.Method
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// The reboot may have been canceled while waiting for the lock.
+		if s.rebootTimer != timer {
+			return
+		}
+		s.reboot(s.rebootReason)
+	})
+	s.rebootTimer = timer
+	log.Infof("Reboot scheduled in %v: %s", delay, request.Message)
+	return &pb.RebootResponse{}, nil
+}
+
+// reboot simulates a reboot of the target. s.mu must be held.
+func (s *Server) reboot(reason string) {
+	log.Infof("Rebooting target: %s", reason)
+	s.rebootTimer = nil
+	s.rebootReason = ""
+	s.rebootMethod = pb.RebootMethod_UNKNOWN
+	s.rebootWhen = time.Time{}
+	s.rebootCount++
+	s.bootTime = now()
+	s.osManager.Reboot()
+}
+
+// RebootStatus returns the status of the pending reboot, if any.
+func (s *Server) RebootStatus(ctx context.Context, _ *pb.RebootStatusRequest) (*pb.RebootStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	response := &pb.RebootStatusResponse{Count: s.rebootCount}
+	if s.rebootTimer != nil {
+		response.Active = true
+		response.When = uint64(s.rebootWhen.UnixNano())
+		response.Reason = s.rebootReason
+		response.Method = s.rebootMethod
+		if wait := s.rebootWhen.Sub(now()); wait > 0 {
+			response.Wait = uint64(wait)
+		}
+	}
+	return response, nil
+}
+
+// CancelReboot cancels the pending reboot, if any.
+func (s *Server) CancelReboot(ctx context.Context, request *pb.CancelRebootRequest) (*pb.CancelRebootResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rebootTimer != nil {
+		s.rebootTimer.Stop()
+		s.rebootTimer = nil
+		log.Infof("Reboot canceled: %s", request.Message)
+	}
+	return &pb.CancelRebootResponse{}, nil
+}
+
+// SwitchControlProcessor makes the requested control processor the active one.
+func (s *Server) SwitchControlProcessor(ctx context.Context, request *pb.SwitchControlProcessorRequest) (*pb.SwitchControlProcessorResponse, error) {
+	name, err := s.controlProcessor(request.ControlProcessor)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	if name != s.activeProcessor {
+		log.Infof("Switching control processor from %s to %s", s.activeProcessor, name)
+		s.activeProcessor = name
+		s.bootTime = now()
+	}
+	bootTime := s.bootTime
+	s.mu.Unlock()
+	version, _ := s.osManager.Running()
+	return &pb.SwitchControlProcessorResponse{
+		ControlProcessor: ComponentPath(name),
+		Version:          version,
+		Uptime:           bootTime.UnixNano(),
+	}, nil
+}
+
+// controlProcessor returns the name of the control processor in path.
+func (s *Server) controlProcessor(path *gnmiPb.Path) (string, error) {
+	name := ComponentName(path)
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "path must be a component, e.g. /components/component[name=RP0]")
+	}
+	for _, processor := range s.processors {
+		if processor == name {
+			return name, nil
+		}
+	}
+	return "", status.Errorf(codes.NotFound, "control processor %q does not exist", name)
+}
+
+// Ping pings the destination through the simulated responder.
+func (s *Server) Ping(request *pb.PingRequest, stream pb.System_PingServer) error {
+	if request.Destination == "" {
+		return status.Error(codes.InvalidArgument, "destination must be specified")
+	}
+	return s.responder.Ping(stream.Context(), request, stream.Send)
+}
+
+// Traceroute traces the route to the destination through the simulated responder.
+func (s *Server) Traceroute(request *pb.TracerouteRequest, stream pb.System_TracerouteServer) error {
+	if request.Destination == "" {
+		return status.Error(codes.InvalidArgument, "destination must be specified")
+	}
+	return s.responder.Traceroute(stream.Context(), request, stream.Send)
+}
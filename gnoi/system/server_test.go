@@ -0,0 +1,213 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/gnxi/gnoi/os"
+	"github.com/google/gnxi/gnoi/system/pb"
+	"github.com/google/go-cmp/cmp"
+	gnmiPb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func newTestServer(t *testing.T, settings *Settings) (*Server, *os.Manager) {
+	t.Helper()
+	manager := os.NewManager("1.0.0a")
+	manager.SetRunning("1.0.0a")
+	manager.Install("1.0.1a", "")
+	manager.Install("1.0.2a", "Failed to activate OS...")
+	return NewServer(settings, manager), manager
+}
+
+func TestRebootActivatesOS(t *testing.T) {
+	tests := []struct {
+		name,
+		activate,
+		wantVersion,
+		wantFailMsg string
+	}{
+		{"activated OS runs after reboot", "1.0.1a", "1.0.1a", ""},
+		{"failed activation keeps running OS", "1.0.2a", "1.0.0a", "Failed to activate OS..."},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, manager := newTestServer(t, &Settings{})
+			if err := manager.Activate(test.activate, true); err != nil {
+				t.Fatalf("Activate(%q, true): %v", test.activate, err)
+			}
+			if _, err := s.Reboot(context.Background(), &pb.RebootRequest{Method: pb.RebootMethod_COLD}); err != nil {
+				t.Fatalf("Reboot: %v", err)
+			}
+			version, failMsg := manager.Running()
+			if version != test.wantVersion || failMsg != test.wantFailMsg {
+				t.Errorf("Running(): got (%q, %q), want (%q, %q)", version, failMsg, test.wantVersion, test.wantFailMsg)
+			}
+			resp, _ := s.RebootStatus(context.Background(), &pb.RebootStatusRequest{})
+			if resp.Count != 1 || resp.Active {
+				t.Errorf("RebootStatus: got %v, want 1 inactive reboot", resp)
+			}
+		})
+	}
+}
+
+func TestRebootErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *pb.RebootRequest
+		wantCode codes.Code
+	}{
+		{"unknown method", &pb.RebootRequest{}, codes.InvalidArgument},
+		{"unsupported method", &pb.RebootRequest{Method: pb.RebootMethod_NSF}, codes.Unimplemented},
+		{"unknown subcomponent", &pb.RebootRequest{Method: pb.RebootMethod_COLD, Subcomponents: []*gnmiPb.Path{ComponentPath("RP9")}}, codes.NotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, _ := newTestServer(t, &Settings{})
+			_, err := s.Reboot(context.Background(), test.request)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("Reboot(%v): got code %s, want %s", test.request, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestDelayedReboot(t *testing.T) {
+	s, manager := newTestServer(t, &Settings{})
+	manager.Activate("1.0.1a", true)
+	ctx := context.Background()
+	if _, err := s.Reboot(ctx, &pb.RebootRequest{Method: pb.RebootMethod_COLD, Delay: uint64(time.Hour), Message: "upgrade"}); err != nil {
+		t.Fatalf("Reboot: %v", err)
+	}
+	if _, err := s.Reboot(ctx, &pb.RebootRequest{Method: pb.RebootMethod_COLD}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Reboot while pending: got %v, want FailedPrecondition", err)
+	}
+	resp, _ := s.RebootStatus(ctx, &pb.RebootStatusRequest{})
+	if !resp.Active || resp.Reason != "upgrade" || resp.Wait == 0 || resp.Method != pb.RebootMethod_COLD {
+		t.Errorf("RebootStatus: got %v, want active COLD reboot for upgrade", resp)
+	}
+	s.CancelReboot(ctx, &pb.CancelRebootRequest{})
+	if resp, _ = s.RebootStatus(ctx, &pb.RebootStatusRequest{}); resp.Active {
+		t.Errorf("RebootStatus after cancel: got %v, want inactive", resp)
+	}
+
+	if _, err := s.Reboot(ctx, &pb.RebootRequest{Method: pb.RebootMethod_COLD, Delay: uint64(time.Millisecond)}); err != nil {
+		t.Fatalf("Reboot: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for version, _ := manager.Running(); version != "1.0.1a"; version, _ = manager.Running() {
+		if time.Now().After(deadline) {
+			t.Fatal("delayed reboot did not happen")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSwitchControlProcessor(t *testing.T) {
+	s, _ := newTestServer(t, &Settings{})
+	ctx := context.Background()
+	resp, err := s.SwitchControlProcessor(ctx, &pb.SwitchControlProcessorRequest{ControlProcessor: ComponentPath("RP1")})
+	if err != nil {
+		t.Fatalf("SwitchControlProcessor: %v", err)
+	}
+	want := &pb.SwitchControlProcessorResponse{ControlProcessor: ComponentPath("RP1"), Version: "1.0.0a", Uptime: resp.Uptime}
+	if diff := cmp.Diff(want, resp, protocmp.Transform()); diff != "" {
+		t.Errorf("SwitchControlProcessor: (-want +got):\n%s", diff)
+	}
+	if _, err := s.Reboot(ctx, &pb.RebootRequest{Method: pb.RebootMethod_COLD, Subcomponents: []*gnmiPb.Path{ComponentPath("RP0")}}); err != nil {
+		t.Errorf("Reboot of standby: %v", err)
+	}
+	if rebootStatus, _ := s.RebootStatus(ctx, &pb.RebootStatusRequest{}); rebootStatus.Count != 0 {
+		t.Errorf("Reboot of standby rebooted the target")
+	}
+	if _, err := s.SwitchControlProcessor(ctx, &pb.SwitchControlProcessorRequest{ControlProcessor: ComponentPath("RP9")}); status.Code(err) != codes.NotFound {
+		t.Errorf("SwitchControlProcessor(RP9): got %v, want NotFound", err)
+	}
+}
+
+func TestPing(t *testing.T) {
+	r := &Responder{RTT: time.Millisecond, Unreachable: []string{"192.0.2.99"}}
+	tests := []struct {
+		name         string
+		destination  string
+		wantReplies  int
+		wantReceived int32
+	}{
+		{"reachable", "gnxi.example.com", 3, 3},
+		{"unreachable", "192.0.2.99", 0, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var responses []*pb.PingResponse
+			err := r.Ping(context.Background(), &pb.PingRequest{Destination: test.destination, Count: 3, Interval: 1}, func(resp *pb.PingResponse) error {
+				responses = append(responses, resp)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Ping: %v", err)
+			}
+			if got := len(responses) - 1; got != test.wantReplies {
+				t.Errorf("got %d replies, want %d", got, test.wantReplies)
+			}
+			summary := responses[len(responses)-1]
+			if summary.Sent != 3 || summary.Received != test.wantReceived {
+				t.Errorf("summary: got sent %d received %d, want sent 3 received %d", summary.Sent, summary.Received, test.wantReceived)
+			}
+			if test.wantReceived > 0 && (summary.MinTime > summary.AvgTime || summary.AvgTime > summary.MaxTime) {
+				t.Errorf("summary: inconsistent times %v", summary)
+			}
+		})
+	}
+}
+
+func TestTraceroute(t *testing.T) {
+	r := &Responder{Hops: 4, Unreachable: []string{"unreachable.example.com"}}
+	tests := []struct {
+		name      string
+		request   *pb.TracerouteRequest
+		wantHops  int
+		wantLast  string
+		wantState pb.TracerouteResponse_State
+	}{
+		{"reachable", &pb.TracerouteRequest{Destination: "192.0.2.200"}, 4, "192.0.2.200", pb.TracerouteResponse_DEFAULT},
+		{"max ttl", &pb.TracerouteRequest{Destination: "192.0.2.200", MaxTtl: 2}, 2, "192.0.2.2", pb.TracerouteResponse_DEFAULT},
+		{"unreachable", &pb.TracerouteRequest{Destination: "unreachable.example.com"}, 1, "192.0.2.1", pb.TracerouteResponse_NETWORK_UNREACHABLE},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var responses []*pb.TracerouteResponse
+			err := r.Traceroute(context.Background(), test.request, func(resp *pb.TracerouteResponse) error {
+				responses = append(responses, resp)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Traceroute: %v", err)
+			}
+			if got := len(responses) - 1; got != test.wantHops {
+				t.Fatalf("got %d hops, want %d", got, test.wantHops)
+			}
+			last := responses[len(responses)-1]
+			if last.Address != test.wantLast || last.State != test.wantState {
+				t.Errorf("last hop: got %s %s, want %s %s", last.Address, last.State, test.wantLast, test.wantState)
+			}
+		})
+	}
+}
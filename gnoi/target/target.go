@@ -0,0 +1,204 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package target runs a gNOI server as a mock target configured by flags,
+// bootstrapping until it has certificates. It is shared by the gnoi_target and
+// gnxi_target binaries.
+package target
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/google/gnxi/gnoi"
+	"github.com/google/gnxi/gnoi/cert"
+	"github.com/google/gnxi/gnoi/file"
+	"github.com/google/gnxi/gnoi/healthz"
+	"github.com/google/gnxi/gnoi/os"
+	"github.com/google/gnxi/gnoi/reset"
+	"github.com/google/gnxi/gnoi/system"
+	"github.com/google/gnxi/utils/credentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var (
+	certID                   = flag.String("cert_id", "default", "Certificate ID for preloaded certificates")
+	bindAddr                 = flag.String("bind_address", ":9339", "Bind to address:port or just :port")
+	resetDelay               = flag.Duration("reset_delay", 3*time.Second, "Delay before the target reboots upon factory reset request, 3 seconds by default")
+	zeroFillUnsupported      = flag.Bool("zero_fill_unsupported", false, "Make the target not support zero filling storage")
+	factoryOSUnsupported     = flag.Bool("reset_unsupported", false, "Make the target not support factory resetting OS")
+	factoryVersion           = flag.String("factoryOS_version", "1.0.0a", "Specify factory OS version, 1.0.0a by default")
+	installedVersions        = flag.String("installedOS_versions", "", "Specify installed OS versions, e.g \"1.0.1a 2.01b\"")
+	receiveChunkSizeAck      = flag.Uint64("chunk_size_ack", 12000000, "The chunk size of the image to respond with a TransfreResponse in bytes. Example: -chunk_size 12000000")
+	osRebootDelay            = flag.Duration("os_reboot_delay", 0, "Specify the delay between an OS activation and the reboot it triggers")
+	osBootTime               = flag.Duration("os_boot_time", 0, "Specify how long the target is unavailable while it reboots, rebooting instantly by default")
+	osTransferDir            = flag.String("os_transfer_dir", "", "Specify the directory OS images are received into, the temporary directory by default")
+	osMaxTransfers           = flag.Int("os_max_transfers", 1, "Specify how many OS images can be received at the same time")
+	osTrustBundle            = flag.String("os_trust_bundle", "", "Specify a PEM file with the CA certificates OS images must be signed by, images needn't be signed by default")
+	dualSupervisor           = flag.Bool("dual_supervisor", false, "Simulate a dual supervisor target with a standby supervisor")
+	standbyID                = flag.String("standby_id", "RP1", "Specify the ID of the standby supervisor of a dual supervisor target, no standby supervisor is present if empty")
+	standbyInstalledVersions = flag.String("standby_installedOS_versions", "", "Specify OS versions installed on the standby supervisor, e.g \"1.0.1a 2.01b\"")
+	unreachable              = flag.String("unreachable", "", "Specify destinations unreachable by Ping and Traceroute, e.g \"192.0.2.1 unreachable.example.com\"")
+	fileRoot                 = flag.String("file_root", "", "Specify the directory holding the files of the File service, gnoi_file in the temporary directory by default")
+	healthzModel             = flag.String("healthz_model", "", "Specify the YAML fault model of the Healthz service, all components are healthy by default")
+)
+
+// Target serves the services of a gNOI server on -bind_address, only those
+// available during bootstrapping until it has certificates, and all of them
+// once provisioned.
+type Target struct {
+	*gnoi.Server
+	// Reflection registers the gRPC reflection service next to the services.
+	Reflection bool
+
+	grpcServer    *grpc.Server
+	muServe       sync.Mutex
+	bootstrapping bool
+	provisioned   bool
+}
+
+// New creates a target with a gNOI server set up by the flags. Services added
+// to its server before Start are served too. Factory resets reboot the target
+// after -reset_delay.
+func New() (*Target, error) {
+	osSettings, err := osSettings()
+	if err != nil {
+		return nil, err
+	}
+	resetSettings := &reset.Settings{
+		ZeroFillUnsupported:  *zeroFillUnsupported,
+		FactoryOSUnsupported: *factoryOSUnsupported,
+	}
+	systemSettings := &system.Settings{
+		Responder: &system.Responder{Unreachable: strings.Fields(*unreachable)},
+	}
+	fileSettings := &file.Settings{Root: *fileRoot}
+	healthzSettings := &healthz.Settings{ModelFile: *healthzModel}
+	certSettings := &cert.Settings{CertID: *certID}
+	credentials.SetTargetName("target.com")
+	certSettings.Cert, certSettings.CA = credentials.ParseCertificates()
+
+	t := &Target{provisioned: certSettings.Cert != nil && certSettings.CA != nil}
+	t.Server, err = gnoi.NewServer(&gnoi.Settings{
+		Cert:        certSettings,
+		Reset:       resetSettings,
+		OS:          osSettings,
+		System:      systemSettings,
+		File:        fileSettings,
+		Healthz:     healthzSettings,
+		NotifyReset: t.notifyReset,
+		Revocation:  credentials.RevocationChecker(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gNOI Server: %v", err)
+	}
+	return t, nil
+}
+
+// osSettings returns the settings of the OS service.
+func osSettings() (*os.Settings, error) {
+	settings := &os.Settings{
+		FactoryVersion:           *factoryVersion,
+		InstalledVersions:        strings.Split(*installedVersions, " "),
+		ReceiveChunkSizeAck:      *receiveChunkSizeAck,
+		RebootDelay:              *osRebootDelay,
+		BootTime:                 *osBootTime,
+		TransferDir:              *osTransferDir,
+		MaxConcurrentTransfers:   *osMaxTransfers,
+		DualSupervisor:           *dualSupervisor,
+		StandbyID:                *standbyID,
+		StandbyInstalledVersions: strings.Fields(*standbyInstalledVersions),
+	}
+	if *osTrustBundle != "" {
+		var err error
+		if settings.TrustBundle, err = os.LoadTrustBundle(*osTrustBundle); err != nil {
+			return nil, fmt.Errorf("failed to load the OS trust bundle: %v", err)
+		}
+	}
+	return settings, nil
+}
+
+// Start starts serving, bootstrapping or provisioned depending on whether
+// certificates were preloaded.
+func (t *Target) Start() {
+	// Registers a caller for whenever the number of installed certificates changes.
+	t.RegisterCertNotifier(t.notifyCerts)
+	t.bootstrapping = t.provisioned
+	numCerts := 0
+	if t.provisioned {
+		numCerts = 1
+	}
+	t.notifyCerts(numCerts, numCerts) // Triggers bootstraping mode.
+}
+
+// serve binds to an address and starts serving a gRPCServer.
+func (t *Target) serve(g *grpc.Server) {
+	t.muServe.Lock()
+	defer t.muServe.Unlock()
+	listen, err := net.Listen("tcp", *bindAddr)
+	if err != nil {
+		log.Fatal("Failed to listen:", err)
+	}
+	defer listen.Close()
+	log.Info("Starting server.")
+	if err := g.Serve(listen); err != nil {
+		log.Fatal("Failed to serve:", err)
+	}
+}
+
+// notifyCerts can be called with the number of certs and ca certs installed. It will
+// (re)start the gRPC server in encrypted mode if no certs are installed. It will
+// (re)start in authenticated mode otherwise.
+func (t *Target) notifyCerts(certs, caCerts int) {
+	hasCredentials := certs != 0 && caCerts != 0
+	if t.bootstrapping != hasCredentials {
+		// Nothing to do, either I am bootstrapping and I have no
+		// certificates or I am provisioned and I have certificates.
+		return
+	}
+	if t.grpcServer != nil {
+		t.grpcServer.GracefulStop()
+	}
+	if t.bootstrapping {
+		log.Info("Found Credentials, setting Provisioned state.")
+		t.grpcServer = t.PrepareAuthenticated()
+		// Register all services.
+		t.Register(t.grpcServer)
+	} else {
+		log.Info("No credentials, setting Bootstrapping state.")
+		t.grpcServer = t.PrepareEncrypted()
+		// Only register the services available during bootstrapping.
+		t.RegisterBootstrapping(t.grpcServer)
+	}
+	if t.Reflection {
+		reflection.Register(t.grpcServer)
+	}
+	t.bootstrapping = !t.bootstrapping
+	go t.serve(t.grpcServer)
+}
+
+// notifyReset is called once the factory reset service wiped the state of the
+// target, which then reboots.
+func (t *Target) notifyReset() {
+	log.Info("Server factory reset triggered")
+	<-time.After(*resetDelay)
+	t.Reboot()
+}
@@ -0,0 +1,45 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOSSettings(t *testing.T) {
+	flag.Set("installedOS_versions", "1.0.1a 2.01b")
+	flag.Set("standby_installedOS_versions", "")
+	defer flag.Set("installedOS_versions", "")
+	settings, err := osSettings()
+	if err != nil {
+		t.Fatalf("osSettings failed: %v", err)
+	}
+	if diff := cmp.Diff([]string{"1.0.1a", "2.01b"}, settings.InstalledVersions); diff != "" {
+		t.Errorf("InstalledVersions: diff (-want +got):\n%s", diff)
+	}
+	if settings.FactoryVersion != "1.0.0a" || settings.StandbyID != "RP1" || len(settings.StandbyInstalledVersions) != 0 {
+		t.Errorf("osSettings: got defaults %q, %q, %v", settings.FactoryVersion, settings.StandbyID, settings.StandbyInstalledVersions)
+	}
+
+	flag.Set("os_trust_bundle", "missing.pem")
+	defer flag.Set("os_trust_bundle", "")
+	if _, err := osSettings(); err == nil {
+		t.Error("osSettings with a missing trust bundle succeeded")
+	}
+}
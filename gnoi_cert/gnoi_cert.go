@@ -22,7 +22,8 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"flag"
-	"fmt"
+	"io/ioutil"
+	"net/http"
 	"reflect"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/google/gnxi/gnoi/cert"
 	credUtils "github.com/google/gnxi/utils/credentials"
 	"github.com/google/gnxi/utils/entity"
+	"github.com/google/gnxi/utils/signer"
 	"github.com/kylelemons/godebug/pretty"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -51,8 +53,16 @@ var (
 	orgUnit    = flag.String("organizational_unit", "gNxI", "Organizational unit in CSR parameters")
 	ipAddress  = flag.String("ip_address", "127.0.0.1", "IP address in CSR parameters")
 	otherCAs   = flag.String("other_cas", "", "Other CA certificate files that will get sent in the CA bundle but are not used to establish a connection or sign the CSR. Only filename prefix required. Suffixes assumed to be .pem and .key")
+	signerType = flag.String("signer", "local", "Backend signing the target CSRs, one of: local, plugin, est")
+	pluginPath = flag.String("signer_plugin", "", "Executable signing CSRs with -signer plugin. Invoked with 'sign' and a PEM CSR on stdin, or 'cacerts'")
+	pluginArgs = flag.String("signer_plugin_args", "", "Comma separated arguments passed to the -signer_plugin executable")
+	estURL     = flag.String("est_url", "", "EST server URL used with -signer est, e.g. https://ca.example.com/.well-known/est")
+	estCA      = flag.String("est_ca", "", "CA certificate file verifying the EST server. System roots are used if empty")
+	estUser    = flag.String("est_username", "", "Username for EST basic authentication")
+	estPass    = flag.String("est_password", "", "Password for EST basic authentication")
 
 	caEnt     *entity.Entity
+	csrSigner signer.Signer
 	caBundle  []*x509.Certificate
 	ctx       context.Context
 	cancel    func()
@@ -75,17 +85,17 @@ func main() {
 
 	switch *op {
 	case "provision":
-		caEnt = credUtils.GetCAEntity()
+		loadSigner()
 		loadCABundle()
 		certIDCheck()
 		provision()
 	case "install":
-		caEnt = credUtils.GetCAEntity()
+		loadSigner()
 		loadCABundle()
 		certIDCheck()
 		install()
 	case "rotate":
-		caEnt = credUtils.GetCAEntity()
+		loadSigner()
 		loadCABundle()
 		certIDCheck()
 		rotate()
@@ -106,6 +116,39 @@ func certIDCheck() {
 	}
 }
 
+// loadSigner sets up the backend that signs the target CSRs.
+func loadSigner() {
+	switch *signerType {
+	case "local":
+		caEnt = credUtils.GetCAEntity()
+		csrSigner = signer.NewLocal(caEnt)
+	case "plugin":
+		if *pluginPath == "" {
+			log.Exit("Must set a plugin executable with -signer_plugin.")
+		}
+		csrSigner = signer.NewPlugin(*pluginPath, strings.FieldsFunc(*pluginArgs, func(r rune) bool { return r == ',' })...)
+	case "est":
+		if *estURL == "" {
+			log.Exit("Must set an EST server URL with -est_url.")
+		}
+		client := http.DefaultClient
+		if *estCA != "" {
+			pemCA, err := ioutil.ReadFile(*estCA)
+			if err != nil {
+				log.Exitf("Failed to read EST CA file %s: %v", *estCA, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pemCA) {
+				log.Exitf("No certificates found in EST CA file %s", *estCA)
+			}
+			client = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+		}
+		csrSigner = signer.NewEST(*estURL, client, *estUser, *estPass)
+	default:
+		log.Exitf("Unknown signer: %q", *signerType)
+	}
+}
+
 func loadCABundle() {
 	if *otherCAs != "" {
 		otherCAFileNames := strings.FieldsFunc(*otherCAs, func(r rune) bool { return r == ',' })
@@ -117,7 +160,11 @@ func loadCABundle() {
 			caBundle = append(caBundle, ent.Certificate.Leaf)
 		}
 	}
-	caBundle = append(caBundle, caEnt.Certificate.Leaf)
+	caCerts, err := csrSigner.CACertificates()
+	if err != nil {
+		log.Exitf("Failed to get the signer CA certificates: %v", err)
+	}
+	caBundle = append(caBundle, caCerts...)
 }
 
 // gnoiEncrypted creates an encrypted TLS connection to the target.
@@ -158,26 +205,21 @@ func gnoiAuthenticated(targetName string) (*grpc.ClientConn, *cert.Client) {
 	return conn, client
 }
 
-// signer is called to create a Certificate from a CSR.
-func signer(csr *x509.CertificateRequest) (*x509.Certificate, error) {
-	e, err := entity.FromSigningRequest(csr)
-	if err != nil {
-		return nil, fmt.Errorf("failed generating a cert from a CSR: %v", err)
-	}
-	if err := e.SignWith(caEnt); err != nil {
-		return nil, fmt.Errorf("failed to sign the certificate: %v", err)
-	}
-	return e.Certificate.Leaf, nil
-}
-
 // provision provisions a target in bootstrapping mode.
 func provision() {
 	// Using the CA x509 cert as default Certificate, but can be any.
+	// Remote signers don't expose the CA, so a self signed one is used instead.
+	if caEnt == nil {
+		var err error
+		if caEnt, err = entity.CreateSelfSigned("gnoi_cert", nil); err != nil {
+			log.Exitf("Failed to create a self signed client certificate: %v", err)
+		}
+	}
 	conn, client := gnoiEncrypted(*caEnt.Certificate)
 	defer conn.Close()
 	pkiName := pkix.Name{CommonName: *targetCN, Organization: []string{*org}, OrganizationalUnit: []string{*orgUnit}, Country: []string{*country}, Province: []string{*state}}
 
-	if err := client.Install(ctx, *certID, uint32(*minKeySize), pkiName, *ipAddress, signer, caBundle); err != nil {
+	if err := client.Install(ctx, *certID, uint32(*minKeySize), pkiName, *ipAddress, csrSigner.Sign, caBundle); err != nil {
 		log.Exit("Failed Install:", err)
 	}
 	log.Info("Install success")
@@ -189,7 +231,7 @@ func install() {
 	defer conn.Close()
 	pkiName := pkix.Name{CommonName: *targetCN, Organization: []string{*org}, OrganizationalUnit: []string{*orgUnit}, Country: []string{*country}, Province: []string{*state}}
 
-	if err := client.Install(ctx, *certID, uint32(*minKeySize), pkiName, *ipAddress, signer, caBundle); err != nil {
+	if err := client.Install(ctx, *certID, uint32(*minKeySize), pkiName, *ipAddress, csrSigner.Sign, caBundle); err != nil {
 		log.Exit("Failed Install:", err)
 	}
 	log.Info("Install success")
@@ -201,7 +243,7 @@ func rotate() {
 	defer conn.Close()
 	pkiName := pkix.Name{CommonName: *targetCN, Organization: []string{*org}, OrganizationalUnit: []string{*orgUnit}, Country: []string{*country}, Province: []string{*state}}
 
-	if err := client.Rotate(ctx, *certID, uint32(*minKeySize), pkiName, *ipAddress, signer, caBundle, func() error { return nil }); err != nil {
+	if err := client.Rotate(ctx, *certID, uint32(*minKeySize), pkiName, *ipAddress, csrSigner.Sign, caBundle, func() error { return nil }); err != nil {
 		log.Exit("Failed Rotate:", err)
 	}
 	log.Info("Rotate success")
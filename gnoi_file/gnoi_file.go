@@ -0,0 +1,166 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Binary implements a gNOI File client.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/google/gnxi/gnoi/file"
+	"github.com/google/gnxi/gnoi/file/pb"
+	"github.com/google/gnxi/utils/credentials"
+	"google.golang.org/grpc"
+)
+
+var (
+	targetAddr  = flag.String("target_addr", ":9339", "The target address in the format of host:port")
+	op          = flag.String("op", "", "File service operation. Can be one of: get, put, stat, remove, transfer")
+	timeOut     = flag.Duration("time_out", 30*time.Second, "Timeout for the operation, 30 seconds by default")
+	remoteFile  = flag.String("remote_file", "", "Absolute path of the file on the target")
+	localFile   = flag.String("local_file", "", "Path of the local file to put, or to write the file got to. Writes to stdout if empty when getting")
+	permissions = flag.String("permissions", "644", "Octal permissions of the file put on the target")
+	remotePath  = flag.String("remote_path", "", "URL to transfer the remote file to with the transfer operation")
+	protocol    = flag.String("protocol", "HTTPS", "Protocol used by the transfer operation. Can be one of: HTTP, HTTPS, SFTP, SCP")
+	username    = flag.String("username", "", "Username to authenticate the transfer operation with")
+	password    = flag.String("password", "", "Password to authenticate the transfer operation with")
+
+	client *file.Client
+	ctx    context.Context
+	cancel func()
+)
+
+func main() {
+	flag.Set("logtostderr", "true")
+	flag.Parse()
+
+	opts := credentials.ClientCredentials()
+	conn, err := grpc.Dial(*targetAddr, opts...)
+	if err != nil {
+		log.Exitf("Dialing to %s failed: %v", *targetAddr, err)
+	}
+	defer conn.Close()
+
+	client = file.NewClient(conn)
+	ctx, cancel = context.WithTimeout(context.Background(), *timeOut)
+	defer cancel()
+
+	ctx = credentials.AttachToContext(ctx)
+
+	if *remoteFile == "" {
+		flag.Usage()
+		log.Exit("No remote file provided. Provide one with -remote_file")
+	}
+
+	switch *op {
+	case "get":
+		get()
+	case "put":
+		put()
+	case "stat":
+		stat()
+	case "remove":
+		remove()
+	case "transfer":
+		transfer()
+	default:
+		flag.Usage()
+		log.Error("Invalid operation provided. Provide one with -op")
+	}
+}
+
+// get copies the remote file to the local file.
+func get() {
+	var w io.Writer = os.Stdout
+	if *localFile != "" {
+		f, err := os.Create(*localFile)
+		if err != nil {
+			log.Exit("Failed to create local file: ", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := client.Get(ctx, *remoteFile, w); err != nil {
+		log.Exit("Failed Get: ", err)
+	}
+	log.Info("Get success")
+}
+
+// put copies the local file to the remote file.
+func put() {
+	if *localFile == "" {
+		log.Exit("No local file provided. Provide one with -local_file")
+	}
+	perm, err := strconv.ParseUint(*permissions, 8, 32)
+	if err != nil {
+		log.Exitf("Invalid permissions %q: %v", *permissions, err)
+	}
+	f, err := os.Open(*localFile)
+	if err != nil {
+		log.Exit("Failed to open local file: ", err)
+	}
+	defer f.Close()
+	if err := client.Put(ctx, f, *remoteFile, uint32(perm)); err != nil {
+		log.Exit("Failed Put: ", err)
+	}
+	log.Info("Put success")
+}
+
+// stat prints the metadata of the remote file or of the files in a remote directory.
+func stat() {
+	stats, err := client.Stat(ctx, *remoteFile)
+	if err != nil {
+		log.Exit("Failed Stat: ", err)
+	}
+	for _, s := range stats {
+		fmt.Printf("%04o %10d %s %s\n", s.Permissions, s.Size, time.Unix(0, int64(s.LastModified)).Format(time.RFC3339), s.Path)
+	}
+}
+
+// remove removes the remote file.
+func remove() {
+	if err := client.Remove(ctx, *remoteFile); err != nil {
+		log.Exit("Failed Remove: ", err)
+	}
+	log.Info("Remove success")
+}
+
+// transfer makes the target transfer the remote file to the remote path.
+func transfer() {
+	if *remotePath == "" {
+		log.Exit("No remote path provided. Provide one with -remote_path")
+	}
+	p, ok := pb.RemoteDownload_Protocol_value[strings.ToUpper(*protocol)]
+	if !ok {
+		log.Exitf("Invalid protocol %q", *protocol)
+	}
+	remote := &pb.RemoteDownload{Path: *remotePath, Protocol: pb.RemoteDownload_Protocol(p)}
+	if *username != "" {
+		remote.Credentials = &pb.Credentials{Username: *username, Password: &pb.Credentials_Cleartext{Cleartext: *password}}
+	}
+	hash, err := client.TransferToRemote(ctx, *remoteFile, remote)
+	if err != nil {
+		log.Exit("Failed TransferToRemote: ", err)
+	}
+	log.Infof("Transfer success, %s hash: %x", hash.GetMethod(), hash.GetHash())
+}
@@ -0,0 +1,162 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Binary implements a gNOI Healthz client.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/google/gnxi/gnoi/healthz"
+	"github.com/google/gnxi/gnoi/healthz/pb"
+	"github.com/google/gnxi/gnoi/system"
+	"github.com/google/gnxi/utils/credentials"
+	"google.golang.org/grpc"
+)
+
+var (
+	targetAddr          = flag.String("target_addr", ":9339", "The target address in the format of host:port")
+	op                  = flag.String("op", "", "Healthz service operation. Can be one of: get, list, acknowledge, check, artifact")
+	timeOut             = flag.Duration("time_out", 30*time.Second, "Timeout for the operation, 30 seconds by default")
+	component           = flag.String("component", "chassis", "Name of the component in the platform tree")
+	id                  = flag.String("id", "", "Id of the event to acknowledge or check, or of the artifact to get")
+	includeAcknowledged = flag.Bool("include_acknowledged", false, "List acknowledged events too")
+	outputFile          = flag.String("output_file", "", "File to write the artifact to. Writes to stdout if empty")
+
+	client *healthz.Client
+	ctx    context.Context
+	cancel func()
+)
+
+func main() {
+	flag.Set("logtostderr", "true")
+	flag.Parse()
+
+	opts := credentials.ClientCredentials()
+	conn, err := grpc.Dial(*targetAddr, opts...)
+	if err != nil {
+		log.Exitf("Dialing to %s failed: %v", *targetAddr, err)
+	}
+	defer conn.Close()
+
+	client = healthz.NewClient(conn)
+	ctx, cancel = context.WithTimeout(context.Background(), *timeOut)
+	defer cancel()
+
+	ctx = credentials.AttachToContext(ctx)
+
+	switch *op {
+	case "get":
+		get()
+	case "list":
+		list()
+	case "acknowledge":
+		acknowledge()
+	case "check":
+		check()
+	case "artifact":
+		artifact()
+	default:
+		flag.Usage()
+		log.Error("Invalid operation provided. Provide one with -op")
+	}
+}
+
+// printStatus prints a component status, indenting subcomponents under it.
+func printStatus(cs *pb.ComponentStatus, indent string) {
+	status := strings.TrimPrefix(cs.Status.String(), "STATUS_")
+	line := fmt.Sprintf("%s%s %s", indent, system.ComponentName(cs.Path), status)
+	if cs.Id != "" {
+		line += fmt.Sprintf(" event=%s created=%s", cs.Id, cs.Created.AsTime().Format(time.RFC3339))
+	}
+	if cs.Acknowledged {
+		line += " acknowledged"
+	}
+	for _, a := range cs.Artifacts {
+		line += fmt.Sprintf(" artifact=%s", a.Id)
+	}
+	fmt.Println(line)
+	for _, sub := range cs.Subcomponents {
+		printStatus(sub, indent+"  ")
+	}
+}
+
+// get prints the health of the component and its subcomponents.
+func get() {
+	cs, err := client.Get(ctx, *component)
+	if err != nil {
+		log.Exit("Failed Get: ", err)
+	}
+	printStatus(cs, "")
+}
+
+// list prints the health events of the component.
+func list() {
+	statuses, err := client.List(ctx, *component, *includeAcknowledged)
+	if err != nil {
+		log.Exit("Failed List: ", err)
+	}
+	for _, cs := range statuses {
+		printStatus(cs, "")
+	}
+}
+
+// acknowledge acknowledges an event of the component.
+func acknowledge() {
+	if *id == "" {
+		log.Exit("No event id provided. Provide one with -id")
+	}
+	if _, err := client.Acknowledge(ctx, *component, *id); err != nil {
+		log.Exit("Failed Acknowledge: ", err)
+	}
+	log.Info("Acknowledge success")
+}
+
+// check runs the health checks of the component.
+func check() {
+	cs, err := client.Check(ctx, *component, *id)
+	if err != nil {
+		log.Exit("Failed Check: ", err)
+	}
+	printStatus(cs, "")
+}
+
+// artifact copies an artifact to the output file.
+func artifact() {
+	if *id == "" {
+		log.Exit("No artifact id provided. Provide one with -id")
+	}
+	var w io.Writer = os.Stdout
+	if *outputFile != "" {
+		f, err := os.Create(*outputFile)
+		if err != nil {
+			log.Exit("Failed to create output file: ", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	header, err := client.Artifact(ctx, *id, w)
+	if err != nil {
+		log.Exit("Failed Artifact: ", err)
+	}
+	log.Infof("Artifact success, %s (%d bytes)", header.GetFile().GetName(), header.GetFile().GetSize())
+}
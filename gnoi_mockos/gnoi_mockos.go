@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 
 	log "github.com/golang/glog"
+	"github.com/google/gnxi/utils/entity"
 	"github.com/google/gnxi/utils/mockos"
 )
 
@@ -26,6 +27,8 @@ var (
 	size                  = flag.String("size", "1M", "The size of the OS package's data, e.g 10M")
 	incompatible          = flag.Bool("incompatible", false, "If true, the os package is valid but incompatible with the target")
 	activationFailMessage = flag.String("activation_fail_message", "", "If set, then the OS will fail to activate")
+	signerCert            = flag.String("signer_cert", "", "If set with -signer_key, the certificate of the signer of the OS package")
+	signerKey             = flag.String("signer_key", "", "If set with -signer_cert, the private key signing the OS package")
 )
 
 func main() {
@@ -37,7 +40,15 @@ func main() {
 		log.Exit("-file and -version must be specified")
 	}
 
-	if err := mockos.GenerateOS(*file, *version, *size, *activationFailMessage, *incompatible); err != nil {
+	var signer *entity.Entity
+	if *signerCert != "" || *signerKey != "" {
+		var err error
+		if signer, err = entity.FromFile(*signerCert, *signerKey); err != nil {
+			log.Exitf("Error loading the signer: %v", err)
+		}
+	}
+
+	if err := mockos.GenerateOS(*file, *version, *size, *activationFailMessage, *incompatible, signer); err != nil {
 		log.Exitf("Error Generating OS: %v", err)
 	}
 	path, err := filepath.Abs(*file)
@@ -27,12 +27,19 @@ import (
 )
 
 var (
-	targetAddr    = flag.String("target_addr", ":9339", "The target address in the format of host:port")
-	version       = flag.String("version", "", "Version of the OS required when using the activate operation or being installed using the install operation")
-	osFile        = flag.String("os", "", "Path to the OS image for the install operation")
-	op            = flag.String("op", "", "OS service operation. Can be one of: install, activate, verify")
-	timeOut       = flag.Duration("time_out", 5*time.Second, "Timeout for the operation, 5 seconds by default")
-	readChunkSize = flag.Uint64("chunk_size", 4000000, "How much of the image to load a time, in bytes. Example: -chunk_size 4000000")
+	targetAddr     = flag.String("target_addr", ":9339", "The target address in the format of host:port")
+	version        = flag.String("version", "", "Version of the OS required when using the activate operation or being installed using the install operation")
+	osFile         = flag.String("os", "", "Path to the OS image for the install operation")
+	op             = flag.String("op", "", "OS service operation. Can be one of: install, activate, verify, upgrade")
+	timeOut        = flag.Duration("time_out", 5*time.Second, "Timeout for the operation, or for every Activate and Verify RPC of an upgrade and for its transfer to make progress, 5 seconds by default")
+	upgradeTimeOut = flag.Duration("upgrade_time_out", 10*time.Minute, "Timeout for the whole upgrade operation, including install retries and the reboot, 10 minutes by default")
+	readChunkSize  = flag.Uint64("chunk_size", 4000000, "How much of the image to load a time, in bytes. Example: -chunk_size 4000000")
+	noReboot       = flag.Bool("no_reboot", false, "Activate the OS version without rebooting the target, it boots on the next reboot")
+	standby        = flag.Bool("standby", false, "Perform the operation on the standby supervisor of a dual supervisor target")
+	retries        = flag.Int("retries", gnoiOS.DefaultRetries, "How many times to retry an install failing with a transient error, resuming the transfer")
+	retryBackoff   = flag.Duration("retry_backoff", gnoiOS.DefaultRetryBackoff, "How long to wait before retrying a failed install, doubling on every retry")
+	pollInterval   = flag.Duration("poll_interval", time.Second, "How often to poll the target for the running version while it reboots during an upgrade")
+	printProgress  = flag.Bool("print_progress", false, "Prints progress periodically of file transfer.")
 
 	client *gnoiOS.Client
 	ctx    context.Context
@@ -51,7 +58,14 @@ func main() {
 	defer conn.Close()
 
 	client = gnoiOS.NewClient(conn)
-	ctx, cancel = context.WithTimeout(context.Background(), *timeOut)
+	client.SetRetry(*retries, *retryBackoff)
+	timeout := *timeOut
+	if *op == "upgrade" {
+		// Every RPC and transfer pause of the upgrade gets -time_out, the whole upgrade takes longer.
+		client.SetTimeout(*timeOut)
+		timeout = *upgradeTimeOut
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	ctx = credentials.AttachToContext(ctx)
@@ -63,6 +77,8 @@ func main() {
 		activate()
 	case "verify":
 		verify()
+	case "upgrade":
+		upgrade()
 	default:
 		flag.Usage()
 		log.Error("Invalid operation provided. Provide one with -op")
@@ -74,23 +90,57 @@ func install() {
 	if *osFile == "" {
 		log.Exit("No OS image path provided. Provide one with -os")
 	}
-	if err := client.Install(ctx, *osFile, *version, *timeOut, *readChunkSize); err != nil {
+	if err := client.Install(ctx, *osFile, *version, *standby, *timeOut, *readChunkSize, progress()); err != nil {
 		log.Exit("Failed Install: ", err)
 	}
 }
 
-// activate activates the OS version to be used upon next reboot on the target.
+// progress returns the callback printing the progress of installs if
+// -print_progress is set, nil otherwise.
+func progress() gnoiOS.ProgressFunc {
+	if !*printProgress {
+		return nil
+	}
+	return gnoiOS.PrintAcknowledged()
+}
+
+// upgrade installs the OS image, activates it and waits for the target to reboot into it.
+func upgrade() {
+	if *osFile == "" {
+		log.Exit("No OS image path provided. Provide one with -os")
+	}
+	if *version == "" {
+		log.Exit("No version provided. Provide one with -version")
+	}
+	if err := client.Upgrade(ctx, *osFile, *version, *standby, *timeOut, *readChunkSize, progress(), *pollInterval); err != nil {
+		log.Exit("Failed Upgrade: ", err)
+	}
+	log.Info("Running OS version: ", *version)
+}
+
+// activate activates the OS version on the target, rebooting it unless -no_reboot is set.
 func activate() {
 	if *version == "" {
 		log.Exit("No version provided. Provide one with -version")
 	}
-	if err := client.Activate(ctx, *version); err != nil {
+	if err := client.Activate(ctx, *version, *noReboot, *standby); err != nil {
 		log.Exit("Failed Activate: ", err)
 	}
 }
 
-// verify verifies the version of the OS running on the target.
+// verify verifies the version of the OS running on the target, or on its standby supervisor.
 func verify() {
+	if *standby {
+		id, version, activationFailMsg, err := client.VerifyStandby(ctx)
+		if err != nil {
+			log.Exit("Failed Verify: ", err)
+		}
+		if activationFailMsg != "" {
+			log.Info("Previous standby activation fail message:", activationFailMsg)
+		}
+		log.Infof("Standby supervisor %s running OS version: %s", id, version)
+		return
+	}
 	version, activationFailMsg, err := client.Verify(ctx)
 	if err != nil {
 		log.Exit("Failed Verify: ", err)
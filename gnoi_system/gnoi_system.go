@@ -0,0 +1,190 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Binary implements a gNOI System client.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/google/gnxi/gnoi/system"
+	"github.com/google/gnxi/gnoi/system/pb"
+	"github.com/google/gnxi/utils/credentials"
+	"google.golang.org/grpc"
+)
+
+var (
+	targetAddr       = flag.String("target_addr", ":9339", "The target address in the format of host:port")
+	op               = flag.String("op", "", "System service operation. Can be one of: reboot, reboot_status, cancel_reboot, time, ping, traceroute, switch_control_processor")
+	timeOut          = flag.Duration("time_out", 10*time.Second, "Timeout for the operation, 10 seconds by default")
+	method           = flag.String("method", "COLD", "Reboot method. Can be one of: COLD, POWERDOWN, HALT, WARM, NSF, POWERUP")
+	delay            = flag.Duration("delay", 0, "Delay before rebooting, reboots immediately by default")
+	message          = flag.String("message", "", "Informational reason for the reboot or its cancellation")
+	force            = flag.Bool("force", false, "Force the reboot even if sanity checks fail")
+	destination      = flag.String("destination", "", "Destination of the ping and traceroute operations")
+	count            = flag.Int("count", 0, "Number of packets to ping, the target's default if 0")
+	interval         = flag.Duration("interval", 0, "Interval between ping packets, the target's default if 0")
+	maxTTL           = flag.Int("max_ttl", 0, "Maximum number of traceroute hops, the target's default if 0")
+	controlProcessor = flag.String("control_processor", "", "Name of the control processor to switch to, e.g RP1")
+
+	client *system.Client
+	ctx    context.Context
+	cancel func()
+)
+
+func main() {
+	flag.Set("logtostderr", "true")
+	flag.Parse()
+
+	opts := credentials.ClientCredentials()
+	conn, err := grpc.Dial(*targetAddr, opts...)
+	if err != nil {
+		log.Exitf("Dialing to %s failed: %v", *targetAddr, err)
+	}
+	defer conn.Close()
+
+	client = system.NewClient(conn)
+	ctx, cancel = context.WithTimeout(context.Background(), *timeOut)
+	defer cancel()
+
+	ctx = credentials.AttachToContext(ctx)
+
+	switch *op {
+	case "reboot":
+		reboot()
+	case "reboot_status":
+		rebootStatus()
+	case "cancel_reboot":
+		cancelReboot()
+	case "time":
+		targetTime()
+	case "ping":
+		ping()
+	case "traceroute":
+		traceroute()
+	case "switch_control_processor":
+		switchControlProcessor()
+	default:
+		flag.Usage()
+		log.Error("Invalid operation provided. Provide one with -op")
+	}
+}
+
+// reboot reboots the target.
+func reboot() {
+	m, ok := pb.RebootMethod_value[strings.ToUpper(*method)]
+	if !ok {
+		log.Exitf("Invalid reboot method %q", *method)
+	}
+	if err := client.Reboot(ctx, pb.RebootMethod(m), *delay, *message, *force); err != nil {
+		log.Exit("Failed Reboot: ", err)
+	}
+	log.Info("Reboot requested successfully")
+}
+
+// rebootStatus prints the status of the pending reboot.
+func rebootStatus() {
+	status, err := client.RebootStatus(ctx)
+	if err != nil {
+		log.Exit("Failed RebootStatus: ", err)
+	}
+	if !status.Active {
+		fmt.Printf("No reboot pending, %d reboots so far\n", status.Count)
+		return
+	}
+	fmt.Printf("%s reboot pending in %v at %v: %s\n", status.Method, time.Duration(status.Wait), time.Unix(0, int64(status.When)), status.Reason)
+}
+
+// cancelReboot cancels the pending reboot.
+func cancelReboot() {
+	if err := client.CancelReboot(ctx, *message); err != nil {
+		log.Exit("Failed CancelReboot: ", err)
+	}
+	log.Info("Reboot canceled successfully")
+}
+
+// targetTime prints the current time on the target.
+func targetTime() {
+	t, err := client.Time(ctx)
+	if err != nil {
+		log.Exit("Failed Time: ", err)
+	}
+	fmt.Println(t)
+}
+
+// ping pings the destination from the target.
+func ping() {
+	if *destination == "" {
+		log.Exit("No destination provided. Provide one with -destination")
+	}
+	request := &pb.PingRequest{
+		Destination: *destination,
+		Count:       int32(*count),
+		Interval:    int64(*interval),
+	}
+	err := client.Ping(ctx, request, func(r *pb.PingResponse) {
+		if r.Sent != 0 {
+			fmt.Printf("%d packets transmitted, %d received, rtt min/avg/max/stddev = %v/%v/%v/%v\n",
+				r.Sent, r.Received, time.Duration(r.MinTime), time.Duration(r.AvgTime), time.Duration(r.MaxTime), time.Duration(r.StdDev))
+			return
+		}
+		fmt.Printf("%d bytes from %s: seq=%d ttl=%d time=%v\n", r.Bytes, r.Source, r.Sequence, r.Ttl, time.Duration(r.Time))
+	})
+	if err != nil {
+		log.Exit("Failed Ping: ", err)
+	}
+}
+
+// traceroute traces the route from the target to the destination.
+func traceroute() {
+	if *destination == "" {
+		log.Exit("No destination provided. Provide one with -destination")
+	}
+	request := &pb.TracerouteRequest{
+		Destination: *destination,
+		MaxTtl:      int32(*maxTTL),
+	}
+	err := client.Traceroute(ctx, request, func(r *pb.TracerouteResponse) {
+		if r.Hop == 0 {
+			fmt.Printf("traceroute to %s (%s), %d hops max, %d byte packets\n", r.DestinationName, r.DestinationAddress, r.Hops, r.PacketSize)
+			return
+		}
+		line := fmt.Sprintf("%2d  %s (%s)  %v", r.Hop, r.Name, r.Address, time.Duration(r.Rtt))
+		if r.State != pb.TracerouteResponse_DEFAULT {
+			line += " " + r.State.String()
+		}
+		fmt.Println(line)
+	})
+	if err != nil {
+		log.Exit("Failed Traceroute: ", err)
+	}
+}
+
+// switchControlProcessor switches the active control processor of the target.
+func switchControlProcessor() {
+	if *controlProcessor == "" {
+		log.Exit("No control processor provided. Provide one with -control_processor")
+	}
+	resp, err := client.SwitchControlProcessor(ctx, *controlProcessor)
+	if err != nil {
+		log.Exit("Failed SwitchControlProcessor: ", err)
+	}
+	log.Infof("Switched to %s running OS version %s, up since %v", *controlProcessor, resp.Version, time.Unix(0, resp.Uptime))
+}
@@ -0,0 +1,85 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Binary gnxi_target implements a Target serving gNMI and gNOI on the same port
+// with a shared TLS identity managed by the gNOI Certificate Management service.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+
+	"github.com/google/gnxi/gnmi"
+	"github.com/google/gnxi/gnmi/modeldata"
+	"github.com/google/gnxi/gnmi/modeldata/gostruct"
+	"github.com/google/gnxi/gnoi/target"
+
+	log "github.com/golang/glog"
+)
+
+var (
+	model = gnmi.NewModel(modeldata.ModelData,
+		reflect.TypeOf((*gostruct.Device)(nil)),
+		gostruct.SchemaTree["Device"],
+		gostruct.Unmarshal,
+		gostruct.ΛEnum)
+
+	configFile = flag.String("config", "", "IETF JSON file for target startup config")
+)
+
+// start creates the gNMI and gNOI servers and starts serving them.
+func start() {
+	var configData []byte
+	if *configFile != "" {
+		var err error
+		if configData, err = ioutil.ReadFile(*configFile); err != nil {
+			log.Exitf("error in reading config file: %v", err)
+		}
+	}
+	gNMIServer, err := gnmi.NewServer(model, configData, nil)
+	if err != nil {
+		log.Exitf("error in creating gNMI server: %v", err)
+	}
+	t, err := target.New()
+	if err != nil {
+		log.Exit(err)
+	}
+	// gNMI is served next to the gNOI services once provisioned, and factory
+	// resets restore its startup config.
+	if err := t.AddService("gnmi", gNMIServer, false); err != nil {
+		log.Exit("Failed to add the gNMI service: ", err)
+	}
+	t.Reflection = true
+	t.Start()
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Supported models:\n")
+		for _, m := range model.SupportedModels() {
+			fmt.Fprintf(os.Stderr, "  %s\n", m)
+		}
+		fmt.Fprintf(os.Stderr, "\n")
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Set("logtostderr", "true")
+	flag.Parse()
+	start()
+	select {} // Loop forever.
+}
@@ -42,7 +42,8 @@ func setDefaults() {
 	viper.SetDefault("order", order)
 	viper.SetDefault("web.prompts", map[string]Prompts{})
 	viper.SetDefault("files", map[string][]string{
-		"gnoi_os": {"os", "new_os"},
+		"gnoi_os":   {"os", "new_os"},
+		"gnoi_file": {"file"},
 	})
 }
 
@@ -152,5 +153,40 @@ func generateTestCases() (Tests, []string) {
 			Prompt:   []string{"non_existent_os_version"},
 		},
 	}
-	return Tests{"gnoi_os": osTests, "gnoi_reset": resetTests, "gnoi_cert": certTests, "provision": provisionTest}, []string{"gnoi_os", "gnoi_cert", "gnoi_reset"}
+	fileTests := []Test{
+		{
+			Name:   "Put File",
+			Args:   map[string]string{"op": "put", "local_file": "&<file>", "remote_file": "&<remote_file>"},
+			Wants:  "Put success",
+			Prompt: []string{"remote_file"},
+		},
+		{
+			Name:  "Stat Put File",
+			Args:  map[string]string{"op": "stat", "remote_file": "&<remote_file>"},
+			Wants: "&<remote_file>",
+		},
+		{
+			Name:  "Get Put File",
+			Args:  map[string]string{"op": "get", "remote_file": "&<remote_file>", "local_file": "/tmp/gnoi_file_get"},
+			Wants: "Get success",
+		},
+		{
+			Name:  "Remove Put File",
+			Args:  map[string]string{"op": "remove", "remote_file": "&<remote_file>"},
+			Wants: "Remove success",
+		},
+		{
+			Name:     "Get Removed File",
+			Args:     map[string]string{"op": "get", "remote_file": "&<remote_file>"},
+			MustFail: true,
+			Wants:    "Failed Get: .*NotFound",
+		},
+		{
+			Name:     "Remove Removed File",
+			Args:     map[string]string{"op": "remove", "remote_file": "&<remote_file>"},
+			MustFail: true,
+			Wants:    "Failed Remove: .*NotFound",
+		},
+	}
+	return Tests{"gnoi_os": osTests, "gnoi_file": fileTests, "gnoi_reset": resetTests, "gnoi_cert": certTests, "provision": provisionTest}, []string{"gnoi_os", "gnoi_file", "gnoi_cert", "gnoi_reset"}
 }
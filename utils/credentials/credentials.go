@@ -23,6 +23,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -31,6 +32,7 @@ import (
 
 	log "github.com/golang/glog"
 	"github.com/google/gnxi/utils/entity"
+	"github.com/google/gnxi/utils/revocation"
 )
 
 var (
@@ -43,6 +45,11 @@ var (
 	TargetName     = flag.String("target_name", "", "The target name used to verify the hostname returned by TLS handshake")
 	insecure       = flag.Bool("insecure", false, "Skip TLS validation.")
 	notls          = flag.Bool("notls", false, "Disable TLS validation. If true, no need to specify TLS related options.")
+	crlFiles       = flag.String("crl", "", "Comma separated list of CRL files client certificates are checked against.")
+	ocspCheck      = flag.Bool("ocsp", false, "Check client certificates against their OCSP responder.")
+	ocspResponder  = flag.String("ocsp_responder", "", "OCSP responder URL overriding the one in the client certificates.")
+	ocspHardFail   = flag.Bool("ocsp_hard_fail", false, "Reject client certificates whose OCSP status can't be retrieved.")
+	revChecker     *revocation.Checker
 	authorizedUser = userCredentials{}
 	usernameKey    = "username"
 	passwordKey    = "password"
@@ -191,6 +198,25 @@ func GetCAEntity() *entity.Entity {
 	return caEnt
 }
 
+// RevocationChecker returns the revocation checker configured by the -crl and
+// -ocsp flags and exits if there's an error.
+func RevocationChecker() *revocation.Checker {
+	if revChecker != nil {
+		return revChecker
+	}
+	settings := &revocation.Settings{
+		CRLFiles:      strings.FieldsFunc(*crlFiles, func(r rune) bool { return r == ',' }),
+		OCSP:          *ocspCheck,
+		OCSPResponder: *ocspResponder,
+		OCSPHardFail:  *ocspHardFail,
+	}
+	var err error
+	if revChecker, err = revocation.NewChecker(settings); err != nil {
+		log.Exitf("Failed to load revocation settings: %v", err)
+	}
+	return revChecker
+}
+
 // ServerCredentials generates gRPC ServerOptions for existing credentials.
 func ServerCredentials() []grpc.ServerOption {
 	if *notls {
@@ -198,19 +224,22 @@ func ServerCredentials() []grpc.ServerOption {
 	}
 
 	certificates, certPool := LoadCertificates()
+	checker := RevocationChecker()
 
 	if *insecure {
 		return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(&tls.Config{
-			ClientAuth:   tls.VerifyClientCertIfGiven,
-			Certificates: certificates,
-			ClientCAs:    certPool,
+			ClientAuth:            tls.VerifyClientCertIfGiven,
+			Certificates:          certificates,
+			ClientCAs:             certPool,
+			VerifyPeerCertificate: checker.VerifyPeerCertificate,
 		}))}
 	}
 
 	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(&tls.Config{
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-		Certificates: certificates,
-		ClientCAs:    certPool,
+		ClientAuth:            tls.RequireAndVerifyClientCert,
+		Certificates:          certificates,
+		ClientCAs:             certPool,
+		VerifyPeerCertificate: checker.VerifyPeerCertificate,
 	}))}
 }
 
@@ -0,0 +1,186 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// reloadDelay lets writers finish updating all the files before reloading.
+var reloadDelay = 500 * time.Millisecond
+
+// Reloader holds TLS material loaded from files and reloads it whenever the
+// files change. Existing connections are not affected by a reload.
+type Reloader struct {
+	certFile, keyFile, caFile string
+
+	mu           sync.RWMutex
+	certificates []tls.Certificate
+	certPool     *x509.CertPool
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// NewReloader loads the certificate, key and CA files and starts watching them.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile, done: make(chan struct{})}
+	if err := r.Load(); err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %v", err)
+	}
+	// Directories are watched, rather than files, to follow files that are
+	// atomically replaced by renaming.
+	dirs := map[string]bool{}
+	for _, f := range []string{certFile, keyFile, caFile} {
+		dirs[filepath.Dir(f)] = true
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("failed to watch %q: %v", dir, err)
+		}
+	}
+	r.watcher = watcher
+	go r.watch()
+	return r, nil
+}
+
+// Load reads the files and replaces the TLS material if they are valid.
+func (r *Reloader) Load() error {
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("could not load key/certificate pair from files: %v", err)
+	}
+	if certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0]); err != nil {
+		return fmt.Errorf("could not parse x509 certificate from tls certificate: %v", err)
+	}
+	caFile, err := ioutil.ReadFile(r.caFile)
+	if err != nil {
+		return fmt.Errorf("could not read CA certificate: %v", err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caFile) {
+		return fmt.Errorf("no CA certificate found in %q", r.caFile)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.certificates = []tls.Certificate{certificate}
+	r.certPool = certPool
+	return nil
+}
+
+func (r *Reloader) isWatched(name string) bool {
+	name = filepath.Clean(name)
+	for _, f := range []string{r.certFile, r.keyFile, r.caFile} {
+		if name == filepath.Clean(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) watch() {
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-r.done:
+			return
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			if r.isWatched(event.Name) && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				reload = time.After(reloadDelay)
+			}
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Errorf("Error watching certificate files: %v", err)
+		case <-reload:
+			reload = nil
+			if err := r.Load(); err != nil {
+				log.Errorf("Keeping previous certificates, failed to reload: %v", err)
+				continue
+			}
+			log.Infof("Reloaded certificates from %s, %s and %s.", r.certFile, r.keyFile, r.caFile)
+		}
+	}
+}
+
+// Close stops watching the files.
+func (r *Reloader) Close() error {
+	close(r.done)
+	return r.watcher.Close()
+}
+
+// TLSCertificates returns the current TLS Certificates and x509 Pool of CA Certificates.
+func (r *Reloader) TLSCertificates() ([]tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.certificates, r.certPool
+}
+
+// TLSConfig returns a tls.Config that uses the current TLS material for every
+// new connection.
+func (r *Reloader) TLSConfig(clientAuth tls.ClientAuthType, verifyPeer func([][]byte, [][]*x509.Certificate) error) *tls.Config {
+	config := func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		certificates, certPool := r.TLSCertificates()
+		return &tls.Config{
+			ClientAuth:            clientAuth,
+			Certificates:          certificates,
+			ClientCAs:             certPool,
+			VerifyPeerCertificate: verifyPeer,
+		}, nil
+	}
+	return &tls.Config{GetConfigForClient: config}
+}
+
+// ReloadingServerCredentials generates gRPC ServerOptions like ServerCredentials,
+// reloading the -cert, -key and -ca files whenever they change.
+func ReloadingServerCredentials() []grpc.ServerOption {
+	if *notls {
+		return []grpc.ServerOption{}
+	}
+	if *ca == "" || *cert == "" || *key == "" {
+		log.Exit("Please provide -ca, -cert & -key to reload certificates")
+	}
+	r, err := NewReloader(*cert, *key, *ca)
+	if err != nil {
+		log.Exitf("Failed to load certificates: %v", err)
+	}
+	clientAuth := tls.RequireAndVerifyClientCert
+	if *insecure {
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(r.TLSConfig(clientAuth, RevocationChecker().VerifyPeerCertificate)))}
+}
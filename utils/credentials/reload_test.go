@@ -0,0 +1,103 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/gnxi/utils/entity"
+)
+
+func writeEntity(t *testing.T, dir string, e, ca *entity.Entity) {
+	t.Helper()
+	files := map[string]*pem.Block{
+		"target.crt": {Type: "CERTIFICATE", Bytes: e.Certificate.Leaf.Raw},
+		"target.key": {Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(e.PrivateKey.(*rsa.PrivateKey))},
+		"ca.crt":     {Type: "CERTIFICATE", Bytes: ca.Certificate.Leaf.Raw},
+	}
+	for name, block := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatal("WriteFile:", err)
+		}
+	}
+}
+
+func TestReloader(t *testing.T) {
+	reloadDelay = 10 * time.Millisecond
+	dir, err := ioutil.TempDir("", "credentials")
+	if err != nil {
+		t.Fatal("TempDir:", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal("CreateSelfSigned:", err)
+	}
+	first, err := entity.CreateSigned("first", nil, ca)
+	if err != nil {
+		t.Fatal("CreateSigned:", err)
+	}
+	second, err := entity.CreateSigned("second", nil, ca)
+	if err != nil {
+		t.Fatal("CreateSigned:", err)
+	}
+	writeEntity(t, dir, first, ca)
+
+	r, err := NewReloader(filepath.Join(dir, "target.crt"), filepath.Join(dir, "target.key"), filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		t.Fatal("NewReloader:", err)
+	}
+	defer r.Close()
+
+	commonName := func() string {
+		config, err := r.TLSConfig(tls.RequireAndVerifyClientCert, nil).GetConfigForClient(&tls.ClientHelloInfo{})
+		if err != nil {
+			t.Fatal("GetConfigForClient:", err)
+		}
+		return config.Certificates[0].Leaf.Subject.CommonName
+	}
+	if got := commonName(); got != "first" {
+		t.Fatalf("got certificate %q, want %q", got, "first")
+	}
+
+	writeEntity(t, dir, second, ca)
+	deadline := time.Now().Add(5 * time.Second)
+	for commonName() != "second" {
+		if time.Now().After(deadline) {
+			t.Fatal("certificates were not reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "target.crt"), []byte("invalid"), 0600); err != nil {
+		t.Fatal("WriteFile:", err)
+	}
+	if err := r.Load(); err == nil {
+		t.Error("Load: expected error for invalid certificate")
+	}
+	if got := commonName(); got != "second" {
+		t.Errorf("got certificate %q after invalid reload, want %q", got, "second")
+	}
+}
@@ -14,20 +14,34 @@ package mockos
 import (
 	"bufio"
 	"bytes"
+	"crypto"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"io"
 	"os"
 
 	"github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/utils/entity"
 	"github.com/google/gnxi/utils/mockos/pb"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 const (
 	cookie = "cookiestring"
+	// MaxFieldSize is the size of the largest field besides the padding of
+	// packages read by ReadOS, which are held in memory.
+	MaxFieldSize = 16 * 1024
 )
 
+// ErrFieldTooLarge is returned by ReadOS for packages with a field larger than
+// MaxFieldSize besides the padding.
+var ErrFieldTooLarge = fmt.Errorf("package field larger than %d bytes", MaxFieldSize)
+
 type OS struct {
 	pb.MockOS
 }
@@ -42,8 +56,53 @@ func (os *OS) CheckHash() bool {
 	return bytes.Equal(os.MockOS.Hash, calcHash(os))
 }
 
-// GenerateOS creates a Mock OS file for gNOI target use.
-func GenerateOS(filename, version, size, activationFailMessage string, incompatible bool) error {
+// Sign signs the hash of the MockOS with the private key of signer and embeds
+// the signature and the certificate of signer in the package. The hash must be
+// calculated first.
+func (os *OS) Sign(signer *entity.Entity) error {
+	key, ok := signer.PrivateKey.(crypto.Signer)
+	if !ok || signer.Certificate == nil || len(signer.Certificate.Certificate) == 0 {
+		return errors.New("signer needs a certificate and a private key")
+	}
+	digest := sha256.Sum256(os.MockOS.Hash)
+	signature, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to sign OS: %v", err)
+	}
+	os.MockOS.Signature = signature
+	os.MockOS.SignerCertificate = signer.Certificate.Certificate[0]
+	return nil
+}
+
+// VerifySignature checks that the embedded hash is signed by a certificate
+// trusted by roots. The hash itself is checked by CheckHash.
+func (os *OS) VerifySignature(roots *x509.CertPool) error {
+	if len(os.MockOS.Signature) == 0 || len(os.MockOS.SignerCertificate) == 0 {
+		return errors.New("OS package is not signed")
+	}
+	cert, err := x509.ParseCertificate(os.MockOS.SignerCertificate)
+	if err != nil {
+		return fmt.Errorf("invalid signer certificate: %v", err)
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		return fmt.Errorf("untrusted signer %q: %v", cert.Subject.CommonName, err)
+	}
+	algorithm := x509.UnknownSignatureAlgorithm
+	switch cert.PublicKeyAlgorithm {
+	case x509.RSA:
+		algorithm = x509.SHA256WithRSA
+	case x509.ECDSA:
+		algorithm = x509.ECDSAWithSHA256
+	}
+	if err := cert.CheckSignature(algorithm, os.MockOS.Hash, os.MockOS.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %v", err)
+	}
+	return nil
+}
+
+// GenerateOS creates a Mock OS file for gNOI target use, signed by signer
+// unless it's nil.
+func GenerateOS(filename, version, size, activationFailMessage string, incompatible bool, signer *entity.Entity) error {
 	if _, err := os.Stat(filename); !os.IsNotExist(err) {
 		return errors.New("File already exists")
 	}
@@ -52,7 +111,7 @@ func GenerateOS(filename, version, size, activationFailMessage string, incompati
 		return err
 	}
 	defer file.Close()
-	out, err := packageOS(version, size, activationFailMessage, incompatible)
+	out, err := packageOS(version, size, activationFailMessage, incompatible, signer)
 	if err != nil {
 		return err
 	}
@@ -66,7 +125,7 @@ func GenerateOS(filename, version, size, activationFailMessage string, incompati
 	return nil
 }
 
-func packageOS(version, size, activationFailMessage string, incompatible bool) ([]byte, error) {
+func packageOS(version, size, activationFailMessage string, incompatible bool, signer *entity.Entity) ([]byte, error) {
 	bufferSize, err := humanize.ParseBytes(size)
 	if err != nil {
 		return nil, err
@@ -81,6 +140,11 @@ func packageOS(version, size, activationFailMessage string, incompatible bool) (
 		ActivationFailMessage: activationFailMessage,
 	}}
 	mockOs.Hash()
+	if signer != nil {
+		if err := mockOs.Sign(signer); err != nil {
+			return nil, err
+		}
+	}
 	out, err := proto.Marshal(&mockOs.MockOS)
 	if err != nil {
 		return nil, err
@@ -97,13 +161,147 @@ func ValidateOS(buf *bytes.Buffer) *OS {
 	return mockOs
 }
 
-// calcHash returns the md5 hash of the OS.
+// Package is a serialized OS package read by ReadOS. Its padding is left in
+// the package instead of being loaded into memory.
+type Package struct {
+	*OS
+	padding *io.SectionReader
+}
+
+// CheckHash calculates the hash of the package, reading its padding, and checks
+// against the embedded hash.
+func (p *Package) CheckHash() bool {
+	hash := hashOS(p.OS, io.NewSectionReader(p.padding, 0, p.padding.Size()))
+	return hash != nil && bytes.Equal(p.MockOS.Hash, hash)
+}
+
+// ReadOS parses the serialized OS package of size bytes in r, typically a file,
+// without loading its padding into memory, so packages of any size can be
+// validated. The other fields are bounded by MaxFieldSize.
+func ReadOS(r io.ReaderAt, size int64) (*Package, error) {
+	p := &Package{OS: &OS{}, padding: io.NewSectionReader(r, 0, 0)}
+	w := &wireReader{r: r, size: size, br: bufio.NewReader(io.NewSectionReader(r, 0, size))}
+	for w.off < size {
+		tag, err := binary.ReadUvarint(w)
+		if err != nil {
+			return nil, err
+		}
+		num, typ := protowire.DecodeTag(tag)
+		switch typ {
+		case protowire.VarintType:
+			v, err := binary.ReadUvarint(w)
+			if err != nil {
+				return nil, err
+			}
+			if num == 5 {
+				p.MockOS.Incompatible = v != 0
+			}
+		case protowire.Fixed32Type:
+			err = w.skip(4)
+		case protowire.Fixed64Type:
+			err = w.skip(8)
+		case protowire.BytesType:
+			var n uint64
+			if n, err = binary.ReadUvarint(w); err != nil {
+				return nil, err
+			}
+			switch num {
+			case 1, 2, 4, 6, 7, 8:
+				if n > MaxFieldSize {
+					return nil, ErrFieldTooLarge
+				}
+				var b []byte
+				if b, err = w.bytes(n); err != nil {
+					return nil, err
+				}
+				switch num {
+				case 1:
+					p.MockOS.Version = string(b)
+				case 2:
+					p.MockOS.Cookie = string(b)
+				case 4:
+					p.MockOS.Hash = b
+				case 6:
+					p.MockOS.ActivationFailMessage = string(b)
+				case 7:
+					p.MockOS.Signature = b
+				case 8:
+					p.MockOS.SignerCertificate = b
+				}
+			case 3:
+				p.padding = io.NewSectionReader(r, w.off, int64(n))
+				err = w.skip(n)
+			default:
+				err = w.skip(n)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d of field %d", typ, num)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// wireReader reads the fields of a serialized proto, tracking their offset.
+type wireReader struct {
+	r    io.ReaderAt
+	size int64
+	off  int64
+	br   *bufio.Reader
+}
+
+// ReadByte implements io.ByteReader to read varints.
+func (w *wireReader) ReadByte() (byte, error) {
+	b, err := w.br.ReadByte()
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if err == nil {
+		w.off++
+	}
+	return b, err
+}
+
+// bytes reads the next n bytes.
+func (w *wireReader) bytes(n uint64) ([]byte, error) {
+	if n > uint64(w.size-w.off) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(w.br, b); err != nil {
+		return nil, err
+	}
+	w.off += int64(n)
+	return b, nil
+}
+
+// skip skips the next n bytes without reading them.
+func (w *wireReader) skip(n uint64) error {
+	if n > uint64(w.size-w.off) {
+		return io.ErrUnexpectedEOF
+	}
+	w.off += int64(n)
+	w.br.Reset(io.NewSectionReader(w.r, w.off, w.size-w.off))
+	return nil
+}
+
+// calcHash returns the hash of the OS.
 func calcHash(os *OS) []byte {
-	bb := []byte(os.MockOS.Version)
-	bb = append(bb, []byte(os.MockOS.Cookie)...)
-	bb = append(bb, []byte(os.MockOS.Padding)...)
-	bb = append(bb, map[bool]byte{false: 0, true: 1}[os.MockOS.Incompatible])
-	bb = append(bb, []byte(os.MockOS.ActivationFailMessage)...)
-	hash := sha256.Sum256(bb)
-	return hash[:]
+	return hashOS(os, bytes.NewReader(os.MockOS.Padding))
+}
+
+// hashOS returns the hash of the OS with the padding read from padding, nil if
+// it can't be read.
+func hashOS(os *OS, padding io.Reader) []byte {
+	h := sha256.New()
+	h.Write([]byte(os.MockOS.Version))
+	h.Write([]byte(os.MockOS.Cookie))
+	if _, err := io.Copy(h, padding); err != nil {
+		return nil
+	}
+	h.Write([]byte{map[bool]byte{false: 0, true: 1}[os.MockOS.Incompatible]})
+	h.Write([]byte(os.MockOS.ActivationFailMessage))
+	return h.Sum(nil)
 }
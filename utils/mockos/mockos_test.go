@@ -3,9 +3,13 @@ package mockos
 import (
 	"bytes"
 	"crypto/rand"
+	"crypto/x509"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/google/gnxi/utils/entity"
 	"github.com/google/gnxi/utils/mockos/pb"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -168,10 +172,108 @@ func TestPackageOS(t *testing.T) {
 	}
 	for _, test := range tests {
 		got := &OS{MockOS: pb.MockOS{}}
-		serializedOS, _ := packageOS(test.os.MockOS.Version, "0", test.os.MockOS.ActivationFailMessage, test.os.MockOS.Incompatible)
+		serializedOS, _ := packageOS(test.os.MockOS.Version, "0", test.os.MockOS.ActivationFailMessage, test.os.MockOS.Incompatible, nil)
 		_ = proto.Unmarshal(serializedOS, &got.MockOS)
 		if diff := cmp.Diff(test.os, got); diff != "" {
 			t.Errorf("ValidateOS(): (-want +got):\n%s", diff)
 		}
 	}
 }
+
+func TestReadOS(t *testing.T) {
+	valid := &OS{MockOS: pb.MockOS{
+		Version:               "1.0a",
+		Cookie:                "cookiestring",
+		Padding:               []byte("These are some bits to pad things out"),
+		ActivationFailMessage: "This is a test activationFailMessage",
+	}}
+	valid.Hash()
+	tampered := &OS{MockOS: valid.MockOS}
+	tampered.MockOS.Hash = []byte("Bad Hash")
+	oversized := &OS{MockOS: valid.MockOS}
+	oversized.MockOS.Cookie = string(make([]byte, MaxFieldSize+1))
+	tests := []struct {
+		name      string
+		os        *OS
+		truncate  int
+		wantErr   error
+		wantCheck bool
+	}{
+		{name: "valid package", os: valid, wantCheck: true},
+		{name: "bad hash", os: tampered},
+		{name: "truncated package", os: valid, truncate: 10, wantErr: io.ErrUnexpectedEOF},
+		{name: "oversized field", os: oversized, wantErr: ErrFieldTooLarge},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serializedOS, _ := proto.Marshal(&test.os.MockOS)
+			serializedOS = serializedOS[:len(serializedOS)-test.truncate]
+			got, err := ReadOS(bytes.NewReader(serializedOS), int64(len(serializedOS)))
+			if err != test.wantErr {
+				t.Fatalf("ReadOS(): got error %v, want error %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			want := &OS{MockOS: test.os.MockOS}
+			want.MockOS.Padding = nil
+			if diff := cmp.Diff(want, got.OS, cmpopts.IgnoreFields(pb.MockOS{}, "XXX_sizecache")); diff != "" {
+				t.Errorf("ReadOS(): (-want +got):\n%s", diff)
+			}
+			if check := got.CheckHash(); check != test.wantCheck {
+				t.Errorf("CheckHash(): got %v, want %v", check, test.wantCheck)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := entity.CreateSigned("signer", nil, ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+	untrusted, err := entity.CreateSelfSigned("untrusted", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate.Leaf)
+	newOS := func(signer *entity.Entity) *OS {
+		os := &OS{MockOS: pb.MockOS{Version: "1.0a", Cookie: cookie, Padding: []byte("padding")}}
+		os.Hash()
+		if signer != nil {
+			if err := os.Sign(signer); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return os
+	}
+	tampered := newOS(signer)
+	tampered.MockOS.Version = "1.0b"
+	tampered.Hash()
+	tests := []struct {
+		name    string
+		os      *OS
+		wantErr string
+	}{
+		{name: "signed", os: newOS(signer)},
+		{name: "unsigned", os: newOS(nil), wantErr: "OS package is not signed"},
+		{name: "untrusted signer", os: newOS(untrusted), wantErr: `untrusted signer "untrusted"`},
+		{name: "tampered", os: tampered, wantErr: "invalid signature"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.os.VerifySignature(roots)
+			if test.wantErr == "" && err != nil {
+				t.Errorf("VerifySignature(): got error %v, want nil", err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.HasPrefix(err.Error(), test.wantErr)) {
+				t.Errorf("VerifySignature(): got error %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ type MockOS struct {
 	Hash                  []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	Incompatible          bool     `protobuf:"varint,5,opt,name=incompatible,proto3" json:"incompatible,omitempty"`
 	ActivationFailMessage string   `protobuf:"bytes,6,opt,name=activation_fail_message,json=activationFailMessage,proto3" json:"activation_fail_message,omitempty"`
+	Signature             []byte   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignerCertificate     []byte   `protobuf:"bytes,8,opt,name=signer_certificate,json=signerCertificate,proto3" json:"signer_certificate,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
 	XXX_unrecognized      []byte   `json:"-"`
 	XXX_sizecache         int32    `json:"-"`
@@ -97,6 +99,20 @@ func (m *MockOS) GetActivationFailMessage() string {
 	return ""
 }
 
+func (m *MockOS) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MockOS) GetSignerCertificate() []byte {
+	if m != nil {
+		return m.SignerCertificate
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MockOS)(nil), "pb.MockOS")
 }
@@ -104,17 +120,20 @@ func init() {
 func init() { proto.RegisterFile("mockos.proto", fileDescriptor_mockos_b5486c52a59d8971) }
 
 var fileDescriptor_mockos_b5486c52a59d8971 = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xc1, 0x8a, 0x83, 0x30,
-	0x10, 0x40, 0x89, 0xeb, 0x66, 0x77, 0x83, 0xa7, 0xc0, 0xee, 0xe6, 0x28, 0x9e, 0x3c, 0xf5, 0x52,
-	0xe8, 0x27, 0xf4, 0x26, 0x05, 0xfb, 0x01, 0x32, 0xc6, 0x54, 0x07, 0x35, 0x13, 0x4c, 0xf0, 0x0b,
-	0xfb, 0x61, 0xa5, 0x69, 0xa5, 0xf4, 0x36, 0xef, 0x3d, 0x86, 0x61, 0x44, 0x36, 0x93, 0x1e, 0xc9,
-	0xef, 0xdc, 0x42, 0x81, 0x64, 0xe2, 0xda, 0xe2, 0xca, 0x04, 0xaf, 0x48, 0x8f, 0xa7, 0xb3, 0x54,
-	0xe2, 0x6b, 0x35, 0x8b, 0x47, 0xb2, 0x8a, 0xe5, 0xac, 0xfc, 0xa9, 0x37, 0x94, 0x7f, 0x82, 0x6b,
-	0xa2, 0x11, 0x8d, 0x4a, 0x62, 0x78, 0xd2, 0x7d, 0xc3, 0x41, 0xd7, 0xa1, 0xed, 0xd5, 0x47, 0xce,
-	0xca, 0xac, 0xde, 0x50, 0x4a, 0x91, 0x0e, 0xe0, 0x07, 0x95, 0x46, 0x1d, 0x67, 0x59, 0x88, 0x0c,
-	0xad, 0xa6, 0xd9, 0x41, 0xc0, 0x76, 0x32, 0xea, 0x33, 0x67, 0xe5, 0x77, 0xfd, 0xe6, 0xe4, 0x41,
-	0xfc, 0x83, 0x0e, 0xb8, 0x42, 0x40, 0xb2, 0xcd, 0x05, 0x70, 0x6a, 0x66, 0xe3, 0x3d, 0xf4, 0x46,
-	0xf1, 0x78, 0xfa, 0xf7, 0x95, 0x8f, 0x80, 0x53, 0xf5, 0x88, 0x2d, 0x8f, 0x1f, 0xed, 0x6f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x29, 0xe9, 0x7a, 0x71, 0xe1, 0x00, 0x00, 0x00,
+	// 225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x49, 0xad, 0x69, 0xbb, 0xe4, 0xe2, 0x80, 0x3a, 0x07, 0x0f, 0xa1, 0xa7, 0x5c, 0xf4,
+	0x22, 0xf8, 0x02, 0x82, 0xb7, 0x22, 0xc4, 0x07, 0x08, 0x93, 0xed, 0x34, 0x1d, 0x92, 0xec, 0x84,
+	0xdd, 0xb5, 0xef, 0xe1, 0x1b, 0x4b, 0xb7, 0x96, 0xe0, 0x6d, 0xbe, 0xff, 0x9b, 0xe1, 0x87, 0x31,
+	0xc5, 0xa8, 0xb6, 0xd7, 0xf0, 0x32, 0x79, 0x8d, 0x0a, 0x8b, 0xa9, 0xdd, 0xfe, 0x2c, 0x4c, 0xbe,
+	0x53, 0xdb, 0x7f, 0x7e, 0x01, 0x9a, 0xd5, 0x89, 0x7d, 0x10, 0x75, 0x98, 0x95, 0x59, 0xb5, 0xa9,
+	0xaf, 0x08, 0x0f, 0x26, 0xb7, 0xaa, 0xbd, 0x30, 0x2e, 0x92, 0xf8, 0xa3, 0xf3, 0xc5, 0x44, 0xfb,
+	0xbd, 0xb8, 0x0e, 0x6f, 0xca, 0xac, 0x2a, 0xea, 0x2b, 0x02, 0x98, 0xe5, 0x91, 0xc2, 0x11, 0x97,
+	0x29, 0x4e, 0x33, 0x6c, 0x4d, 0x21, 0xce, 0xea, 0x38, 0x51, 0x94, 0x76, 0x60, 0xbc, 0x2d, 0xb3,
+	0x6a, 0x5d, 0xff, 0xcb, 0xe0, 0xcd, 0x3c, 0x92, 0x8d, 0x72, 0xa2, 0x28, 0xea, 0x9a, 0x03, 0xc9,
+	0xd0, 0x8c, 0x1c, 0x02, 0x75, 0x8c, 0x79, 0xaa, 0xbe, 0x9f, 0xf5, 0x07, 0xc9, 0xb0, 0xbb, 0x48,
+	0x78, 0x32, 0x9b, 0x20, 0x9d, 0xa3, 0xf8, 0xed, 0x19, 0x57, 0xa9, 0x74, 0x0e, 0xe0, 0xd9, 0xc0,
+	0x19, 0xd8, 0x37, 0x96, 0x7d, 0x94, 0x83, 0x58, 0x8a, 0x8c, 0xeb, 0xb4, 0x76, 0x77, 0x31, 0xef,
+	0xb3, 0x68, 0xf3, 0xf4, 0x9e, 0xd7, 0xdf, 0x01, 0x00, 0xd1, 0xbc, 0x6f, 0xe3, 0x2e, 0x01, 0x00,
+	0x00,
 }
@@ -0,0 +1,278 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package revocation checks peer certificates against CRLs, OCSP responders
+// and a local deny list.
+package revocation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/crypto/ocsp"
+)
+
+// DefaultCacheTTL is how long an OCSP response is cached when the responder
+// doesn't provide a next update time.
+const DefaultCacheTTL = 5 * time.Minute
+
+// Settings configures the revocation checks of a Checker.
+type Settings struct {
+	// CRLFiles are PEM or DER encoded CRL files. They are reloaded whenever
+	// their modification time changes.
+	CRLFiles []string
+	// OCSP enables checking certificates against OCSP responders.
+	OCSP bool
+	// OCSPResponder overrides the responder URL found in the certificates.
+	OCSPResponder string
+	// OCSPHardFail rejects certificates whose status can't be retrieved.
+	OCSPHardFail bool
+	// CacheTTL overrides DefaultCacheTTL.
+	CacheTTL time.Duration
+}
+
+type crlFile struct {
+	modTime time.Time
+	crl     *pkix.CertificateList
+	issuer  []byte
+	revoked map[string]bool
+}
+
+type ocspEntry struct {
+	status  int
+	expires time.Time
+}
+
+// Checker verifies that peer certificates are not revoked.
+type Checker struct {
+	settings Settings
+	client   *http.Client
+
+	mu        sync.RWMutex
+	crls      map[string]*crlFile
+	ocspCache map[string]*ocspEntry
+	denied    map[[sha256.Size]byte]string
+}
+
+var nowTime = time.Now
+
+// NewChecker returns a Checker, loading the CRL files in settings.
+func NewChecker(settings *Settings) (*Checker, error) {
+	c := &Checker{
+		client:    &http.Client{Timeout: 5 * time.Second},
+		crls:      map[string]*crlFile{},
+		ocspCache: map[string]*ocspEntry{},
+		denied:    map[[sha256.Size]byte]string{},
+	}
+	if settings != nil {
+		c.settings = *settings
+	}
+	if c.settings.CacheTTL == 0 {
+		c.settings.CacheTTL = DefaultCacheTTL
+	}
+	for _, file := range c.settings.CRLFiles {
+		if err := c.loadCRL(file); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// loadCRL (re)loads a CRL file if it changed since it was last loaded.
+func (c *Checker) loadCRL(file string) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return fmt.Errorf("failed to stat CRL file %q: %v", file, err)
+	}
+	c.mu.RLock()
+	loaded, ok := c.crls[file]
+	c.mu.RUnlock()
+	if ok && loaded.modTime.Equal(info.ModTime()) {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read CRL file %q: %v", file, err)
+	}
+	crl, err := x509.ParseCRL(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse CRL file %q: %v", file, err)
+	}
+	issuer, err := asn1.Marshal(crl.TBSCertList.Issuer)
+	if err != nil {
+		return fmt.Errorf("failed to marshal issuer of CRL file %q: %v", file, err)
+	}
+	revoked := map[string]bool{}
+	for _, rc := range crl.TBSCertList.RevokedCertificates {
+		revoked[rc.SerialNumber.String()] = true
+	}
+	c.mu.Lock()
+	c.crls[file] = &crlFile{modTime: info.ModTime(), crl: crl, issuer: issuer, revoked: revoked}
+	c.mu.Unlock()
+	log.Infof("Loaded CRL %q with %d revoked certificates.", file, len(revoked))
+	return nil
+}
+
+// Deny adds certificates to the deny list. Denied certificates are rejected
+// anywhere in a peer's chain.
+func (c *Checker) Deny(reason string, certs ...*x509.Certificate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cert := range certs {
+		c.denied[sha256.Sum256(cert.Raw)] = reason
+	}
+}
+
+// VerifyPeerCertificate checks the verified chains of a peer, it can be used as
+// tls.Config.VerifyPeerCertificate.
+func (c *Checker) VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	for _, raw := range rawCerts {
+		if err := c.checkDenied(raw); err != nil {
+			return err
+		}
+	}
+	for _, chain := range verifiedChains {
+		for i := 0; i < len(chain)-1; i++ {
+			if err := c.Check(chain[i], chain[i+1]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Checker) checkDenied(raw []byte) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if reason, ok := c.denied[sha256.Sum256(raw)]; ok {
+		return fmt.Errorf("certificate is denied: %s", reason)
+	}
+	return nil
+}
+
+// Check returns an error if cert, issued by issuer, is revoked.
+func (c *Checker) Check(cert, issuer *x509.Certificate) error {
+	if err := c.checkDenied(cert.Raw); err != nil {
+		return err
+	}
+	if err := c.checkCRLs(cert, issuer); err != nil {
+		return err
+	}
+	if c.settings.OCSP {
+		return c.checkOCSP(cert, issuer)
+	}
+	return nil
+}
+
+func (c *Checker) checkCRLs(cert, issuer *x509.Certificate) error {
+	for _, file := range c.settings.CRLFiles {
+		if err := c.loadCRL(file); err != nil {
+			log.Errorf("Using previously loaded CRL: %v", err)
+		}
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for file, crl := range c.crls {
+		if !bytes.Equal(crl.issuer, cert.RawIssuer) {
+			continue
+		}
+		if err := issuer.CheckCRLSignature(crl.crl); err != nil {
+			return fmt.Errorf("CRL %q is not signed by %q: %v", file, issuer.Subject.CommonName, err)
+		}
+		if crl.crl.HasExpired(nowTime()) {
+			log.Warningf("CRL %q has expired.", file)
+		}
+		if crl.revoked[cert.SerialNumber.String()] {
+			return fmt.Errorf("certificate %q with serial %s is revoked by CRL %q", cert.Subject.CommonName, cert.SerialNumber, file)
+		}
+	}
+	return nil
+}
+
+func (c *Checker) checkOCSP(cert, issuer *x509.Certificate) error {
+	key := string(issuer.RawSubjectPublicKeyInfo) + cert.SerialNumber.String()
+	c.mu.RLock()
+	entry, ok := c.ocspCache[key]
+	c.mu.RUnlock()
+	if !ok || nowTime().After(entry.expires) {
+		var err error
+		if entry, err = c.queryOCSP(cert, issuer); err != nil {
+			if c.settings.OCSPHardFail {
+				return fmt.Errorf("failed to check OCSP status of %q: %v", cert.Subject.CommonName, err)
+			}
+			log.Warningf("Failed to check OCSP status of %q: %v", cert.Subject.CommonName, err)
+			return nil
+		}
+		c.mu.Lock()
+		c.ocspCache[key] = entry
+		c.mu.Unlock()
+	}
+	switch entry.status {
+	case ocsp.Good:
+		return nil
+	case ocsp.Revoked:
+		return fmt.Errorf("certificate %q with serial %s is revoked by OCSP", cert.Subject.CommonName, cert.SerialNumber)
+	}
+	if c.settings.OCSPHardFail {
+		return fmt.Errorf("certificate %q has unknown OCSP status", cert.Subject.CommonName)
+	}
+	return nil
+}
+
+func (c *Checker) queryOCSP(cert, issuer *x509.Certificate) (*ocspEntry, error) {
+	responder := c.settings.OCSPResponder
+	if responder == "" {
+		if len(cert.OCSPServer) == 0 {
+			return nil, fmt.Errorf("no OCSP responder configured or found in the certificate")
+		}
+		responder = cert.OCSPServer[0]
+	}
+	req, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCSP request: %v", err)
+	}
+	resp, err := c.client.Post(responder, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed OCSP request to %q: %v", responder, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OCSP responder %q returned %s", responder, resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCSP response: %v", err)
+	}
+	parsed, err := ocsp.ParseResponseForCert(body, cert, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OCSP response: %v", err)
+	}
+	expires := nowTime().Add(c.settings.CacheTTL)
+	if !parsed.NextUpdate.IsZero() && parsed.NextUpdate.Before(expires) {
+		expires = parsed.NextUpdate
+	}
+	return &ocspEntry{status: parsed.Status, expires: expires}, nil
+}
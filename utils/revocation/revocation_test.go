@@ -0,0 +1,167 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/gnxi/utils/entity"
+	"golang.org/x/crypto/ocsp"
+)
+
+func newChain(t *testing.T) (*entity.Entity, *entity.Entity, *entity.Entity) {
+	t.Helper()
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal("CreateSelfSigned:", err)
+	}
+	good, err := entity.CreateSigned("good", nil, ca)
+	if err != nil {
+		t.Fatal("CreateSigned:", err)
+	}
+	bad, err := entity.CreateSigned("bad", nil, ca)
+	if err != nil {
+		t.Fatal("CreateSigned:", err)
+	}
+	return ca, good, bad
+}
+
+func TestCRL(t *testing.T) {
+	ca, good, bad := newChain(t)
+	crl, err := ca.Certificate.Leaf.CreateCRL(rand.Reader, ca.PrivateKey, []pkix.RevokedCertificate{
+		{SerialNumber: bad.Certificate.Leaf.SerialNumber, RevocationTime: time.Now()},
+	}, time.Now(), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal("CreateCRL:", err)
+	}
+	dir, err := ioutil.TempDir("", "revocation")
+	if err != nil {
+		t.Fatal("TempDir:", err)
+	}
+	defer os.RemoveAll(dir)
+	crlFile := filepath.Join(dir, "ca.crl")
+	if err := ioutil.WriteFile(crlFile, crl, 0600); err != nil {
+		t.Fatal("WriteFile:", err)
+	}
+	c, err := NewChecker(&Settings{CRLFiles: []string{crlFile}})
+	if err != nil {
+		t.Fatal("NewChecker:", err)
+	}
+
+	tests := []struct {
+		name    string
+		cert    *entity.Entity
+		wantErr bool
+	}{
+		{name: "not revoked", cert: good},
+		{name: "revoked", cert: bad, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chain := [][]*x509.Certificate{{test.cert.Certificate.Leaf, ca.Certificate.Leaf}}
+			err := c.VerifyPeerCertificate([][]byte{test.cert.Certificate.Leaf.Raw}, chain)
+			if (err != nil) != test.wantErr {
+				t.Errorf("VerifyPeerCertificate: got error %v, want error %v", err, test.wantErr)
+			}
+		})
+	}
+
+	if _, err := NewChecker(&Settings{CRLFiles: []string{filepath.Join(dir, "missing.crl")}}); err == nil {
+		t.Error("NewChecker: expected error for missing CRL file")
+	}
+}
+
+func TestOCSP(t *testing.T) {
+	ca, good, bad := newChain(t)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		req, err := ocsp.ParseRequest(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		status := ocsp.Good
+		if req.SerialNumber.Cmp(bad.Certificate.Leaf.SerialNumber) == 0 {
+			status = ocsp.Revoked
+		}
+		resp, err := ocsp.CreateResponse(ca.Certificate.Leaf, ca.Certificate.Leaf, ocsp.Response{
+			Status:       status,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   time.Now(),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now(),
+		}, ca.PrivateKey.(crypto.Signer))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(resp)
+	}))
+	defer server.Close()
+
+	c, err := NewChecker(&Settings{OCSP: true, OCSPResponder: server.URL, OCSPHardFail: true})
+	if err != nil {
+		t.Fatal("NewChecker:", err)
+	}
+	if err := c.Check(good.Certificate.Leaf, ca.Certificate.Leaf); err != nil {
+		t.Errorf("Check(good): %v", err)
+	}
+	if err := c.Check(good.Certificate.Leaf, ca.Certificate.Leaf); err != nil {
+		t.Errorf("Check(good) from cache: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("got %d OCSP requests, want 1 with caching", got)
+	}
+	if err := c.Check(bad.Certificate.Leaf, ca.Certificate.Leaf); err == nil {
+		t.Error("Check(bad): expected error for revoked certificate")
+	}
+
+	soft, err := NewChecker(&Settings{OCSP: true, OCSPResponder: "http://127.0.0.1:0"})
+	if err != nil {
+		t.Fatal("NewChecker:", err)
+	}
+	if err := soft.Check(good.Certificate.Leaf, ca.Certificate.Leaf); err != nil {
+		t.Errorf("Check with unreachable responder and soft fail: %v", err)
+	}
+}
+
+func TestDeny(t *testing.T) {
+	ca, good, bad := newChain(t)
+	c, err := NewChecker(nil)
+	if err != nil {
+		t.Fatal("NewChecker:", err)
+	}
+	c.Deny("revoked by test", bad.Certificate.Leaf)
+	if err := c.VerifyPeerCertificate([][]byte{good.Certificate.Leaf.Raw, ca.Certificate.Leaf.Raw}, nil); err != nil {
+		t.Errorf("VerifyPeerCertificate(good): %v", err)
+	}
+	if err := c.VerifyPeerCertificate([][]byte{bad.Certificate.Leaf.Raw, ca.Certificate.Leaf.Raw}, nil); err == nil {
+		t.Error("VerifyPeerCertificate(bad): expected error for denied certificate")
+	}
+}
@@ -0,0 +1,222 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// EST signs CSRs through an Enrollment over Secure Transport (RFC 7030) server.
+type EST struct {
+	url      string
+	client   *http.Client
+	username string
+	password string
+}
+
+// NewEST returns an EST signer for the server at url, for example
+// "https://ca.example.com/.well-known/est". If username is set, requests use
+// HTTP basic authentication.
+func NewEST(url string, client *http.Client, username, password string) *EST {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &EST{url: strings.TrimSuffix(url, "/"), client: client, username: username, password: password}
+}
+
+func (e *EST) do(method, op string, body []byte) ([]*x509.Certificate, error) {
+	req, err := http.NewRequest(method, e.url+"/"+op, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EST %s request: %v", op, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/pkcs10")
+		req.Header.Set("Content-Transfer-Encoding", "base64")
+	}
+	if e.username != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed EST %s request: %v", op, err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read EST %s response: %v", op, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("EST %s returned %s: %s", op, resp.Status, bytes.TrimSpace(data))
+	}
+	der, err := base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(data), nil)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode EST %s response: %v", op, err)
+	}
+	certs, err := parseCertsOnly(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EST %s response: %v", op, err)
+	}
+	return certs, nil
+}
+
+// Sign enrolls the CSR with the EST server and returns the issued certificate.
+func (e *EST) Sign(csr *x509.CertificateRequest) (*x509.Certificate, error) {
+	body := []byte(base64.StdEncoding.EncodeToString(csr.Raw))
+	certs, err := e.do(http.MethodPost, "simpleenroll", body)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+// CACertificates fetches the current CA certificates from the EST server.
+func (e *EST) CACertificates() ([]*x509.Certificate, error) {
+	return e.do(http.MethodGet, "cacerts", nil)
+}
+
+var (
+	oidData       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      contentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+// parseCertsOnly parses the certificates out of a degenerate PKCS#7 SignedData
+// structure, the "certs-only" format EST uses for its responses.
+func parseCertsOnly(der []byte) ([]*x509.Certificate, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PKCS#7 ContentInfo: %v", err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("unexpected PKCS#7 content type %v", ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PKCS#7 SignedData: %v", err)
+	}
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificates: %v", err)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates in PKCS#7 SignedData")
+	}
+	return certs, nil
+}
+
+// marshalCertsOnly wraps certificates in a degenerate PKCS#7 SignedData structure.
+func marshalCertsOnly(certs []*x509.Certificate) ([]byte, error) {
+	var raw []byte
+	for _, c := range certs {
+		raw = append(raw, c.Raw...)
+	}
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true},
+		ContentInfo:      contentInfo{ContentType: oidData},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: raw},
+		SignerInfos:      asn1.RawValue{Tag: asn1.TagSet, IsCompound: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	content, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{FullBytes: content},
+	})
+}
+
+// NewESTHandler returns an http.Handler serving the EST simpleenroll and
+// cacerts operations backed by s. It stands in for a real EST server in
+// labs and tests.
+func NewESTHandler(s Signer) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/est/cacerts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		certs, err := s.CACertificates()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeCertsOnly(w, certs)
+	})
+	mux.HandleFunc("/.well-known/est/simpleenroll", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		der, err := base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(body), nil)))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode CSR: %v", err), http.StatusBadRequest)
+			return
+		}
+		csr, err := x509.ParseCertificateRequest(der)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to parse CSR: %v", err), http.StatusBadRequest)
+			return
+		}
+		cert, err := s.Sign(csr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeCertsOnly(w, []*x509.Certificate{cert})
+	})
+	return mux
+}
+
+func writeCertsOnly(w http.ResponseWriter, certs []*x509.Certificate) {
+	der, err := marshalCertsOnly(certs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pkcs7-mime; smime-type=certs-only")
+	w.Header().Set("Content-Transfer-Encoding", "base64")
+	w.Write([]byte(base64.StdEncoding.EncodeToString(der)))
+}
@@ -0,0 +1,97 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os/exec"
+)
+
+var execCommand = exec.Command
+
+// Plugin delegates signing to an external process, typically a wrapper around
+// a PKCS#11 token or HSM that never exposes the CA private key.
+//
+// The process is invoked as `<path> <args...> sign` with a PEM encoded CSR on
+// stdin and must write the PEM encoded certificate to stdout. When invoked as
+// `<path> <args...> cacerts` it must write the PEM encoded CA certificates.
+type Plugin struct {
+	path string
+	args []string
+}
+
+// NewPlugin returns a Plugin signer running the executable at path with args.
+func NewPlugin(path string, args ...string) *Plugin {
+	return &Plugin{path: path, args: args}
+}
+
+func (p *Plugin) run(op string, stdin []byte) ([]*x509.Certificate, error) {
+	cmd := execCommand(p.path, append(append([]string{}, p.args...), op)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("signer plugin %q failed to %s: %v: %s", p.path, op, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	certs, err := parsePEMCertificates(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("signer plugin %q returned an invalid response to %s: %v", p.path, op, err)
+	}
+	return certs, nil
+}
+
+// Sign sends the CSR to the plugin process and returns the certificate it signed.
+func (p *Plugin) Sign(csr *x509.CertificateRequest) (*x509.Certificate, error) {
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})
+	certs, err := p.run("sign", csrPEM)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+// CACertificates returns the CA certificates reported by the plugin process.
+func (p *Plugin) CACertificates() ([]*x509.Certificate, error) {
+	return p.run("cacerts", nil)
+}
+
+// parsePEMCertificates parses all CERTIFICATE blocks in data.
+func parsePEMCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
@@ -0,0 +1,60 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package signer provides backends that turn Certificate Signing Requests
+// into signed certificates, with or without access to the CA private key.
+package signer
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/google/gnxi/utils/entity"
+)
+
+// Signer signs Certificate Signing Requests on behalf of a CA.
+type Signer interface {
+	// Sign creates a certificate out of a Certificate Signing Request.
+	Sign(csr *x509.CertificateRequest) (*x509.Certificate, error)
+	// CACertificates returns the CA certificates that issue the signed certificates.
+	CACertificates() ([]*x509.Certificate, error)
+}
+
+// Local signs CSRs with a CA Entity whose private key is available locally.
+type Local struct {
+	ca *entity.Entity
+}
+
+// NewLocal returns a Local signer for the CA entity.
+func NewLocal(ca *entity.Entity) *Local {
+	return &Local{ca: ca}
+}
+
+// Sign creates a certificate out of a CSR and signs it with the CA private key.
+func (l *Local) Sign(csr *x509.CertificateRequest) (*x509.Certificate, error) {
+	e, err := entity.FromSigningRequest(csr)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating a cert from a CSR: %v", err)
+	}
+	if err := e.SignWith(l.ca); err != nil {
+		return nil, fmt.Errorf("failed to sign the certificate: %v", err)
+	}
+	return e.Certificate.Leaf, nil
+}
+
+// CACertificates returns the CA certificate.
+func (l *Local) CACertificates() ([]*x509.Certificate, error) {
+	return []*x509.Certificate{l.ca.Certificate.Leaf}, nil
+}
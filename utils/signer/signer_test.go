@@ -0,0 +1,147 @@
+/* Copyright 2020 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/google/gnxi/utils/entity"
+)
+
+func newCSR(t *testing.T, cn string) *x509.CertificateRequest {
+	t.Helper()
+	e, err := entity.NewEntity(entity.Template(cn), nil)
+	if err != nil {
+		t.Fatal("NewEntity:", err)
+	}
+	der, err := e.SigningRequest()
+	if err != nil {
+		t.Fatal("SigningRequest:", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatal("ParseCertificateRequest:", err)
+	}
+	return csr
+}
+
+func testSigner(t *testing.T, s Signer, ca *entity.Entity) {
+	t.Helper()
+	cert, err := s.Sign(newCSR(t, "target.com"))
+	if err != nil {
+		t.Fatal("Sign:", err)
+	}
+	if cert.Subject.CommonName != "target.com" {
+		t.Errorf("Sign: got CommonName %q, want %q", cert.Subject.CommonName, "target.com")
+	}
+	if err := cert.CheckSignatureFrom(ca.Certificate.Leaf); err != nil {
+		t.Errorf("Sign: certificate not signed by CA: %v", err)
+	}
+	caCerts, err := s.CACertificates()
+	if err != nil {
+		t.Fatal("CACertificates:", err)
+	}
+	if len(caCerts) != 1 || !caCerts[0].Equal(ca.Certificate.Leaf) {
+		t.Errorf("CACertificates: got %d certificates, want the CA certificate", len(caCerts))
+	}
+}
+
+func TestLocal(t *testing.T) {
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal("CreateSelfSigned:", err)
+	}
+	testSigner(t, NewLocal(ca), ca)
+}
+
+func TestEST(t *testing.T) {
+	ca, err := entity.CreateSelfSigned("ca", nil)
+	if err != nil {
+		t.Fatal("CreateSelfSigned:", err)
+	}
+	server := httptest.NewTLSServer(NewESTHandler(NewLocal(ca)))
+	defer server.Close()
+
+	testSigner(t, NewEST(server.URL+"/.well-known/est/", server.Client(), "", ""), ca)
+
+	if _, err := NewEST(server.URL+"/unknown", server.Client(), "", "").Sign(newCSR(t, "target.com")); err == nil {
+		t.Error("Sign: expected error from unknown EST path")
+	}
+}
+
+// TestHelperProcess isn't a real test, it's the plugin process run by TestPlugin.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	defer os.Exit(0)
+	ca, err := entity.FromFile(os.Getenv("CA_CERT"), os.Getenv("CA_KEY"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	switch os.Args[len(os.Args)-1] {
+	case "cacerts":
+		pem.Encode(os.Stdout, &pem.Block{Type: "CERTIFICATE", Bytes: ca.Certificate.Leaf.Raw})
+	case "sign":
+		in, _ := ioutil.ReadAll(os.Stdin)
+		block, _ := pem.Decode(in)
+		if block == nil {
+			fmt.Fprintln(os.Stderr, "no CSR on stdin")
+			os.Exit(1)
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		cert, err := NewLocal(ca).Sign(csr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		pem.Encode(os.Stdout, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	default:
+		fmt.Fprintln(os.Stderr, "unknown operation")
+		os.Exit(2)
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	ca, err := entity.FromFile("../entity/testData/root.crt", "../entity/testData/root.key")
+	if err != nil {
+		t.Fatal("FromFile:", err)
+	}
+	execCommand = func(name string, args ...string) *exec.Cmd {
+		cmd := exec.Command(os.Args[0], append([]string{"-test.run=TestHelperProcess", "--", name}, args...)...)
+		cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1", "CA_CERT=../entity/testData/root.crt", "CA_KEY=../entity/testData/root.key")
+		return cmd
+	}
+	defer func() { execCommand = exec.Command }()
+
+	testSigner(t, NewPlugin("pkcs11-signer", "-slot", "0"), ca)
+
+	if _, err := NewPlugin("pkcs11-signer").run("unknown", nil); err == nil {
+		t.Error("run: expected error from failing plugin process")
+	}
+}
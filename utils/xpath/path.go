@@ -18,6 +18,8 @@ package xpath
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	pb "github.com/openconfig/gnmi/proto/gnmi"
 )
@@ -64,3 +66,34 @@ func ToGNMIPath(xpath string) (*pb.Path, error) {
 	}
 	return &pb.Path{Elem: pbPathElements}, nil
 }
+
+// ToXPath returns the xpath string of the concatenation of paths, usually a
+// prefix and a path relative to it, the inverse of ToGNMIPath. List keys are
+// sorted by name and ']' in key values is escaped. Origins and targets are
+// left out.
+func ToXPath(paths ...*pb.Path) string {
+	var b strings.Builder
+	for _, path := range paths {
+		if len(path.GetElem()) == 0 {
+			for _, elem := range path.GetElement() {
+				b.WriteString("/" + elem)
+			}
+			continue
+		}
+		for _, elem := range path.GetElem() {
+			b.WriteString("/" + elem.GetName())
+			keys := make([]string, 0, len(elem.GetKey()))
+			for k := range elem.GetKey() {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				fmt.Fprintf(&b, "[%s=%s]", k, strings.Replace(elem.GetKey()[k], "]", `\]`, -1))
+			}
+		}
+	}
+	if b.Len() == 0 {
+		return "/"
+	}
+	return b.String()
+}
@@ -129,3 +129,38 @@ func TestToGNMIPath(t *testing.T) {
 		}
 	}
 }
+
+func TestToXPath(t *testing.T) {
+	tests := []struct {
+		desc  string
+		paths []*pb.Path
+		want  string
+	}{{
+		desc:  "empty path",
+		paths: []*pb.Path{{}},
+		want:  "/",
+	}, {
+		desc: "prefix and path",
+		paths: []*pb.Path{
+			{Elem: []*pb.PathElem{{Name: "interfaces"}}},
+			{Elem: []*pb.PathElem{{Name: "interface", Key: map[string]string{"name": "eth0"}}, {Name: "state"}}},
+		},
+		want: "/interfaces/interface[name=eth0]/state",
+	}, {
+		desc: "sorted keys with escaped values",
+		paths: []*pb.Path{
+			{Elem: []*pb.PathElem{{Name: "a"}, {Name: "b", Key: map[string]string{"k2": "10.10.10.10/24", "k1": "v]"}}}},
+		},
+		want: `/a/b[k1=v\]][k2=10.10.10.10/24]`,
+	}, {
+		desc:  "deprecated elements",
+		paths: []*pb.Path{{Element: []string{"a", "b"}}},
+		want:  "/a/b",
+	}}
+
+	for _, test := range tests {
+		if got := ToXPath(test.paths...); got != test.want {
+			t.Errorf("%s: ToXPath got: %q, wanted: %q", test.desc, got, test.want)
+		}
+	}
+}
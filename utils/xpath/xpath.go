@@ -189,9 +189,9 @@ func parseKeyValueString(str string) (map[string]string, error) {
 // parseElement parses a split path element, and returns the parsed elements.
 // Two types of path elements are supported:
 //
-// 1. Non-List schema node names which must be valid YANG identifiers. A valid
-// schema node name is returned as it is. For example, given "abc", this API
-// returns []interface{"abc"}.
+// 1. Non-List schema node names which must be valid YANG identifiers, or the
+// "*" and "..." wildcards of gNMI paths. A valid schema node name is returned
+// as it is. For example, given "abc", this API returns []interface{"abc"}.
 //
 // 2. List elements following this pattern: list-name[k1=v1], where list-name
 // is the substring from the beginning of the input string to the first '[', k1
@@ -210,7 +210,7 @@ func parseKeyValueString(str string) (map[string]string, error) {
 func parseElement(elem string) ([]interface{}, error) {
 	i := strings.Index(elem, "[")
 	if i < 0 {
-		if !idRe.MatchString(elem) {
+		if !idRe.MatchString(elem) && elem != "*" && elem != "..." {
 			return nil, fmt.Errorf("invalid node name: %q", elem)
 		}
 		return []interface{}{elem}, nil
@@ -148,6 +148,16 @@ func TestParseElement(t *testing.T) {
 		elem:     "a-b_c0",
 		expectOK: true,
 		want:     []interface{}{"a-b_c0"},
+	}, {
+		desc:     "test wildcard node name success",
+		elem:     "*",
+		expectOK: true,
+		want:     []interface{}{"*"},
+	}, {
+		desc:     "test multi-level wildcard node name success",
+		elem:     "...",
+		expectOK: true,
+		want:     []interface{}{"..."},
 	}, {
 		desc: "test empty string",
 		elem: "",